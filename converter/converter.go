@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // Config holds conversion configuration options
@@ -12,8 +13,40 @@ type Config struct {
 	AddCSS           bool   // Include CSS styling block in output
 	ReverseChangelog bool   // Reverse changelog entries (newest first)
 	PrettifyChecks   bool   // Replace ✓ with ✅
+	EscapeWikitext   bool   // Wrap literal [[, {{ and leading ;:#* in prose in <nowiki>
+	CodeTag          string // Code block tag: "syntaxhighlight" (default) or "source" for older wikis
+	ShowLineNumbers  bool   // Add the "line" attribute to code blocks
+	DetectLanguage   bool   // Guess the language of code blocks that don't name one
+
+	// LanguageHints are checked before the built-in language heuristics
+	LanguageHints []LanguageHint
+}
+
+// LanguageHint maps code matching Pattern to a syntax highlighting language.
+type LanguageHint struct {
+	Language string
+	Pattern  *regexp.Regexp
+}
+
+// defaultLanguageHints are the built-in heuristics for unlabeled code
+// blocks, checked in order against the trimmed code.
+var defaultLanguageHints = []LanguageHint{
+	{Language: "python", Pattern: regexp.MustCompile(`^#!.*\bpython`)},
+	{Language: "bash", Pattern: regexp.MustCompile(`^#!/`)},
+	{Language: "json", Pattern: regexp.MustCompile(`^[{\[]`)},
+	{Language: "xml", Pattern: regexp.MustCompile(`^<`)},
+	{Language: "go", Pattern: regexp.MustCompile(`(?m)^(?:package \w+$|func [\w(])`)},
+	{Language: "python", Pattern: regexp.MustCompile(`(?m)^(?:def \w+\(|import \w|from [\w.]+ import )`)},
+	{Language: "sql", Pattern: regexp.MustCompile(`(?i)SELECT|FROM`)},
+	{Language: "yaml", Pattern: regexp.MustCompile(`(?m)^[A-Za-z_][\w.-]*:(?:\s|$)`)},
 }
 
+// Code block tags supported by Config.CodeTag
+const (
+	CodeTagSyntaxHighlight = "syntaxhighlight"
+	CodeTagSource          = "source"
+)
+
 // DefaultConfig returns sensible defaults for conversion
 func DefaultConfig() Config {
 	return Config{
@@ -21,6 +54,9 @@ func DefaultConfig() Config {
 		AddCSS:           false,
 		ReverseChangelog: true,
 		PrettifyChecks:   true,
+		CodeTag:          CodeTagSyntaxHighlight,
+		ShowLineNumbers:  true,
+		DetectLanguage:   true,
 	}
 }
 
@@ -34,15 +70,26 @@ func Convert(markdown string, config Config) string {
 		text = generateCSS(theme) + "\n\n" + text
 	}
 
+	// Magic words pass through untouched
+	text, magicWords := protectMagicWords(text)
+
 	// Process in order (code first to protect special chars)
-	text = convertCode(text, theme)
-	text = convertBoldItalic(text)
+	text = convertCode(text, theme, config)
+	// HTML comments pass through verbatim, like code blocks
+	text, comments := protectComments(text)
+	if config.EscapeWikitext {
+		text = escapeWikitext(text)
+	}
+	text = convertBoldItalic(text, theme)
 	text = convertHeaders(text, theme)
 	text = convertLinks(text)
 	text = convertCallouts(text, theme)
+	text = convertBlockquotes(text)
 	text = convertLists(text)
 	text = convertTables(text)
 	text = convertHorizontalRules(text)
+	text = restoreComments(text, comments)
+	text = restoreMagicWords(text, magicWords)
 
 	// Post-processing
 	if config.ReverseChangelog {
@@ -84,10 +131,11 @@ func convertHeaders(text string, theme Theme) string {
 	return strings.Join(result, "\n")
 }
 
-// convertBoldItalic converts bold and italic formatting
-func convertBoldItalic(text string) string {
+// convertBoldItalic converts bold and italic formatting, using the theme's
+// highlight color for ==highlights==
+func convertBoldItalic(text string, theme Theme) string {
 	// Protect code blocks from processing
-	codeBlockRegex := regexp.MustCompile(`(?s)<syntaxhighlight[^>]*>.*?</syntaxhighlight>`)
+	codeBlockRegex := regexp.MustCompile(`(?s)<syntaxhighlight[^>]*>.*?</syntaxhighlight>|<source[^>]*>.*?</source>`)
 	codeBlocks := codeBlockRegex.FindAllString(text, -1)
 	for i, block := range codeBlocks {
 		placeholder := fmt.Sprintf("XYZCODEBLOCKREPLACEMENTXYZ%dXYZ", i)
@@ -102,9 +150,11 @@ func convertBoldItalic(text string) string {
 		text = strings.Replace(text, code, placeholder, 1)
 	}
 
-	// Obsidian highlights: ==text== -> <mark>text</mark>
+	// Obsidian highlights: ==text==, ==green:text== or =={.warn}text== -> <mark>text</mark>
 	highlightRegex := regexp.MustCompile(`==([^=\n]+)==`)
-	text = highlightRegex.ReplaceAllString(text, `<mark style="background-color:#f5ff56">$1</mark>`)
+	text = highlightRegex.ReplaceAllStringFunc(text, func(match string) string {
+		return renderHighlight(match[2:len(match)-2], theme)
+	})
 
 	// Bold: **text** or __text__ -> '''text'''
 	boldRegex1 := regexp.MustCompile(`\*\*(.+?)\*\*`)
@@ -144,16 +194,66 @@ func convertLinks(text string) string {
 	return text
 }
 
+// highlightClassRegex matches a leading highlight class hint, either
+// "name:" or "{.name}".
+var highlightClassRegex = regexp.MustCompile(`^(?:\{\.([\w-]+)\}|([\w-]+):)`)
+
+// renderHighlight wraps highlighted content in <mark>. A leading class hint
+// naming one of the theme's Highlights selects that color; anything else,
+// including an unknown name, keeps the theme's default highlight and the
+// content as written.
+func renderHighlight(content string, theme Theme) string {
+	color := theme.HighlightColor
+	if m := highlightClassRegex.FindStringSubmatch(content); m != nil {
+		if named, ok := theme.Highlights[strings.ToLower(m[1]+m[2])]; ok {
+			color = named
+			content = content[len(m[0]):]
+		}
+	}
+	if color == "" {
+		return "<mark>" + content + "</mark>"
+	}
+	return fmt.Sprintf(`<mark style="background-color:%s">%s</mark>`, color, content)
+}
+
+// calloutRegexes holds the compiled patterns for one callout type.
+type calloutRegexes struct {
+	multi  *regexp.Regexp // > [!TYPE]\n> content
+	single *regexp.Regexp // > [!TYPE] content
+}
+
+var (
+	// calloutRegexCache maps a callout type to its calloutRegexes. The
+	// patterns depend only on the type, so they are shared by all themes.
+	calloutRegexCache sync.Map
+
+	// calloutQuotePrefixRegex strips the "> " prefix from callout lines
+	calloutQuotePrefixRegex = regexp.MustCompile(`^>\s?`)
+)
+
+// calloutRegexesFor returns the cached patterns for calloutType, compiling
+// them on first use.
+func calloutRegexesFor(calloutType string) calloutRegexes {
+	if cached, ok := calloutRegexCache.Load(calloutType); ok {
+		return cached.(calloutRegexes)
+	}
+	quoted := regexp.QuoteMeta(calloutType)
+	res := calloutRegexes{
+		multi:  regexp.MustCompile(`(?im)^>\s*\[!` + quoted + `\]\s*\n?((?:>.*\n?)+)`),
+		single: regexp.MustCompile(`(?im)^>\s*\[!` + quoted + `\]\s+(.+)$`),
+	}
+	calloutRegexCache.Store(calloutType, res)
+	return res
+}
+
 // convertCallouts converts markdown callouts to MediaWiki styled boxes
 func convertCallouts(text string, theme Theme) string {
 	for calloutType, style := range theme.Callouts {
-		// Multi-line callout: > [!TYPE]\n> content
-		pattern := fmt.Sprintf(`(?im)^>\s*\[!%s\]\s*\n?((?:>.*\n?)+)`, calloutType)
-		calloutRegex := regexp.MustCompile(pattern)
+		res := calloutRegexesFor(calloutType)
 
-		text = calloutRegex.ReplaceAllStringFunc(text, func(match string) string {
-			contentRegex := regexp.MustCompile(`(?im)^>\s*\[!` + calloutType + `\]\s*\n?((?:>.*\n?)+)`)
-			submatch := contentRegex.FindStringSubmatch(match)
+		// Multi-line callout: > [!TYPE]\n> content
+		text = res.multi.ReplaceAllStringFunc(text, func(match string) string {
+			submatch := res.multi.FindStringSubmatch(match)
 			if len(submatch) < 2 {
 				return match
 			}
@@ -162,7 +262,7 @@ func convertCallouts(text string, theme Theme) string {
 			contentLines := strings.Split(submatch[1], "\n")
 			var cleanLines []string
 			for _, line := range contentLines {
-				cleaned := regexp.MustCompile(`^>\s?`).ReplaceAllString(line, "")
+				cleaned := calloutQuotePrefixRegex.ReplaceAllString(line, "")
 				if strings.TrimSpace(cleaned) != "" || len(cleanLines) > 0 {
 					cleanLines = append(cleanLines, cleaned)
 				}
@@ -177,10 +277,8 @@ func convertCallouts(text string, theme Theme) string {
 		})
 
 		// Single-line callout: > [!TYPE] content
-		singleLinePattern := fmt.Sprintf(`(?im)^>\s*\[!%s\]\s+(.+)$`, calloutType)
-		singleLineRegex := regexp.MustCompile(singleLinePattern)
-		text = singleLineRegex.ReplaceAllStringFunc(text, func(match string) string {
-			submatch := singleLineRegex.FindStringSubmatch(match)
+		text = res.single.ReplaceAllStringFunc(text, func(match string) string {
+			submatch := res.single.FindStringSubmatch(match)
 			if len(submatch) < 2 {
 				return match
 			}
@@ -198,7 +296,16 @@ func convertCallouts(text string, theme Theme) string {
 }
 
 // convertCode converts code formatting
-func convertCode(text string, theme Theme) string {
+func convertCode(text string, theme Theme, config Config) string {
+	tag := CodeTagSyntaxHighlight
+	if config.CodeTag == CodeTagSource {
+		tag = CodeTagSource
+	}
+	lineAttr := ""
+	if config.ShowLineNumbers {
+		lineAttr = " line"
+	}
+
 	// Fenced code blocks first
 	codeBlockRegex := regexp.MustCompile("(?s)```(\\w+)?\\n(.*?)```")
 	text = codeBlockRegex.ReplaceAllStringFunc(text, func(match string) string {
@@ -208,10 +315,13 @@ func convertCode(text string, theme Theme) string {
 
 		// Auto-detect language if not specified
 		if lang == "" {
-			lang = detectLanguage(code)
+			lang = "text"
+			if config.DetectLanguage {
+				lang = detectLanguage(code, config.LanguageHints)
+			}
 		}
 
-		return fmt.Sprintf("<syntaxhighlight lang=\"%s\" line>\n%s\n</syntaxhighlight>", lang, code)
+		return fmt.Sprintf("<%s lang=\"%s\"%s>\n%s\n</%s>", tag, lang, lineAttr, code, tag)
 	})
 
 	// Inline code: `code` -> <code style="...">code</code>
@@ -224,15 +334,28 @@ func convertCode(text string, theme Theme) string {
 	return text
 }
 
-// detectLanguage attempts to auto-detect code language
-func detectLanguage(code string) string {
+// opensCodeBlock reports whether a line starts a code block emitted by
+// convertCode, with either tag.
+func opensCodeBlock(line string) bool {
+	return strings.Contains(line, "<syntaxhighlight") || strings.Contains(line, "<source")
+}
+
+// closesCodeBlock reports whether a line ends a code block emitted by
+// convertCode.
+func closesCodeBlock(line string) bool {
+	return strings.Contains(line, "</syntaxhighlight>") || strings.Contains(line, "</source>")
+}
+
+// detectLanguage attempts to auto-detect code language, trying the caller's
+// hints before the built-in ones
+func detectLanguage(code string, hints []LanguageHint) string {
 	codeStripped := strings.TrimSpace(code)
-	if strings.HasPrefix(codeStripped, "{") || strings.HasPrefix(codeStripped, "[") {
-		return "json"
-	} else if strings.HasPrefix(codeStripped, "<") {
-		return "xml"
-	} else if strings.Contains(strings.ToUpper(code), "SELECT") || strings.Contains(strings.ToUpper(code), "FROM") {
-		return "sql"
+	for _, group := range [][]LanguageHint{hints, defaultLanguageHints} {
+		for _, hint := range group {
+			if hint.Pattern != nil && hint.Pattern.MatchString(codeStripped) {
+				return hint.Language
+			}
+		}
 	}
 	return "text"
 }
@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// blockquoteLineRegex matches a Markdown blockquote line, capturing the text
+// after the first ">" and its optional following space.
+var blockquoteLineRegex = regexp.MustCompile(`^\s*> ?(.*)$`)
+
+// convertBlockquotes converts runs of ">"-prefixed lines to <blockquote>
+// elements, nesting for ">>". It runs after convertCallouts, so the
+// "> [!TYPE]" callouts have already been replaced. Lines inside code blocks
+// are left alone.
+func convertBlockquotes(text string) string {
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+
+	inCode := false
+	var quote []string
+	flush := func() {
+		if quote != nil {
+			result = append(result, renderBlockquote(quote)...)
+			quote = nil
+		}
+	}
+
+	for _, line := range lines {
+		if !inCode {
+			if matches := blockquoteLineRegex.FindStringSubmatch(line); matches != nil {
+				quote = append(quote, matches[1])
+				continue
+			}
+		}
+		flush()
+		if opensCodeBlock(line) {
+			inCode = true
+		}
+		if closesCodeBlock(line) {
+			inCode = false
+		}
+		result = append(result, line)
+	}
+	flush()
+
+	return strings.Join(result, "\n")
+}
+
+// renderBlockquote wraps the de-prefixed lines of one blockquote, converting
+// any nested ">" runs recursively.
+func renderBlockquote(lines []string) []string {
+	inner := strings.Split(convertBlockquotes(strings.Join(lines, "\n")), "\n")
+	out := make([]string, 0, len(inner)+2)
+	out = append(out, "<blockquote>")
+	out = append(out, inner...)
+	return append(out, "</blockquote>")
+}
@@ -0,0 +1,32 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// htmlCommentRegex matches an HTML comment, which may span several lines.
+var htmlCommentRegex = regexp.MustCompile(`(?s)<!--.*?-->`)
+
+// protectComments swaps HTML comments for placeholders so directives such as
+// <!-- **keep** | # --> reach the wikitext verbatim instead of being rewritten
+// by the formatting, list and table passes. The returned slice restores them
+// via restoreComments.
+func protectComments(text string) (string, []string) {
+	var comments []string
+	text = htmlCommentRegex.ReplaceAllStringFunc(text, func(match string) string {
+		comments = append(comments, match)
+		return fmt.Sprintf("XYZHTMLCOMMENTREPLACEMENTXYZ%dXYZ", len(comments)-1)
+	})
+	return text, comments
+}
+
+// restoreComments puts back the comments replaced by protectComments.
+func restoreComments(text string, comments []string) string {
+	for i, comment := range comments {
+		placeholder := fmt.Sprintf("XYZHTMLCOMMENTREPLACEMENTXYZ%dXYZ", i)
+		text = strings.Replace(text, placeholder, comment, 1)
+	}
+	return text
+}
@@ -0,0 +1,56 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// wikitextOpenRegex matches the openers of wiki links and templates.
+	wikitextOpenRegex = regexp.MustCompile(`\[\[|\{\{`)
+	// markdownBlockRegex matches lines the converter turns into lists or
+	// headers, whose leading "*", "#" or "-" is intended markup.
+	markdownBlockRegex = regexp.MustCompile(`^\s*(?:[-*]\s|\d+\.\s|#{1,6}\s)`)
+)
+
+// escapeWikitext wraps wikitext-significant sequences in plain prose in
+// <nowiki> so they render literally: "[[" and "{{" anywhere, and a leading
+// ";", ":", "#" or unpaired "*" that Markdown does not treat as a list or
+// header. It runs after convertCode, and lines inside code blocks are left
+// alone.
+func escapeWikitext(text string) string {
+	lines := strings.Split(text, "\n")
+	inCode := false
+	for i, line := range lines {
+		if opensCodeBlock(line) {
+			inCode = true
+		}
+		if !inCode {
+			line = wikitextOpenRegex.ReplaceAllString(line, "<nowiki>$0</nowiki>")
+			lines[i] = escapeLeadingMarkup(line)
+		}
+		if closesCodeBlock(line) {
+			inCode = false
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escapeLeadingMarkup escapes a line-initial character MediaWiki would read
+// as a list or definition marker.
+func escapeLeadingMarkup(line string) string {
+	if line == "" || markdownBlockRegex.MatchString(line) {
+		return line
+	}
+	switch line[0] {
+	case ';', ':', '#':
+	case '*':
+		// A paired "*" is Markdown emphasis, converted before MediaWiki sees it
+		if strings.Count(line, "*") > 1 {
+			return line
+		}
+	default:
+		return line
+	}
+	return "<nowiki>" + line[:1] + "</nowiki>" + line[1:]
+}
@@ -19,22 +19,21 @@ func convertLists(text string) string {
 	unorderedRegex := regexp.MustCompile(`^(\s*)[-\*]\s+(.*)$`)
 	orderedRegex := regexp.MustCompile(`^(\s*)\d+\.\s+(.*)$`)
 
+	unit := listIndentUnit(lines, unorderedRegex, orderedRegex)
 	var listStack []listItem
 
 	for _, line := range lines {
 		if matches := unorderedRegex.FindStringSubmatch(line); matches != nil {
-			indent := len(matches[1])
 			content := matches[2]
-			currentLevel := indent / 2
+			currentLevel := listIndentLevel(matches[1], unit)
 
 			prefix := buildListPrefix(listStack, currentLevel, "*")
 			line = prefix + " " + content
 			listStack = updateListStack(listStack, currentLevel, "*")
 
 		} else if matches := orderedRegex.FindStringSubmatch(line); matches != nil {
-			indent := len(matches[1])
 			content := matches[2]
-			currentLevel := indent / 2
+			currentLevel := listIndentLevel(matches[1], unit)
 
 			prefix := buildListPrefix(listStack, currentLevel, "#")
 			line = prefix + " " + content
@@ -50,15 +49,48 @@ func convertLists(text string) string {
 	return strings.Join(result, "\n")
 }
 
+// listIndentUnit detects how many spaces make one nesting level in the
+// document: the smallest space-only indent of any list item, or 2 when no
+// item is indented with spaces.
+func listIndentUnit(lines []string, listRegexes ...*regexp.Regexp) int {
+	unit := 0
+	for _, line := range lines {
+		for _, re := range listRegexes {
+			matches := re.FindStringSubmatch(line)
+			if matches == nil {
+				continue
+			}
+			indent := matches[1]
+			if n := len(indent); n > 0 && strings.Trim(indent, " ") == "" && (unit == 0 || n < unit) {
+				unit = n
+			}
+			break
+		}
+	}
+	if unit == 0 {
+		return 2
+	}
+	return unit
+}
+
+// listIndentLevel converts a list item's leading whitespace to a nesting
+// level: each tab is one level, and spaces count in units of unit.
+func listIndentLevel(indent string, unit int) int {
+	tabs := strings.Count(indent, "\t")
+	spaces := strings.Count(indent, " ")
+	return tabs + spaces/unit
+}
+
+// buildListPrefix returns the MediaWiki prefix for an item: the list types
+// of its parent levels followed by the item's own type. The item's own level
+// always uses currentType, so switching between bullets and numbers at the
+// same depth starts the new list type.
 func buildListPrefix(stack []listItem, currentLevel int, currentType string) string {
 	prefix := ""
-	for i := 0; i <= currentLevel && i < len(stack); i++ {
+	for i := 0; i < currentLevel && i < len(stack); i++ {
 		prefix += stack[i].listType
 	}
-	if currentLevel >= len(stack) {
-		prefix += currentType
-	}
-	return prefix
+	return prefix + currentType
 }
 
 func updateListStack(stack []listItem, currentLevel int, currentType string) []listItem {
@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// magicWordRegex matches MediaWiki behavior switches (__NOTOC__) and the
+// page-level magic words that take a value ({{DISPLAYTITLE:...}}). Only
+// known names are matched, so Markdown bold such as __Note__ still converts.
+var magicWordRegex = regexp.MustCompile(`__(?:NOTOC|FORCETOC|TOC|NOEDITSECTION|NEWSECTIONLINK|NONEWSECTIONLINK|NOGALLERY|HIDDENCAT|EXPECTUNUSEDCATEGORY|NOCONTENTCONVERT|NOCC|NOTITLECONVERT|NOTC|INDEX|NOINDEX|STATICREDIRECT|DISAMBIG)__|\{\{(?:DISPLAYTITLE|DEFAULTSORT|DEFAULTSORTKEY|DEFAULTCATEGORYSORT):[^{}\n]*\}\}`)
+
+// protectMagicWords swaps magic words for placeholders so the formatting
+// passes cannot turn their underscores into bold or italics. The returned
+// slice restores them via restoreMagicWords.
+func protectMagicWords(text string) (string, []string) {
+	var words []string
+	text = magicWordRegex.ReplaceAllStringFunc(text, func(match string) string {
+		words = append(words, match)
+		return fmt.Sprintf("XYZMAGICWORDREPLACEMENTXYZ%dXYZ", len(words)-1)
+	})
+	return text, words
+}
+
+// restoreMagicWords puts back the magic words replaced by protectMagicWords.
+func restoreMagicWords(text string, words []string) string {
+	for i, word := range words {
+		placeholder := fmt.Sprintf("XYZMAGICWORDREPLACEMENTXYZ%dXYZ", i)
+		text = strings.Replace(text, placeholder, word, 1)
+	}
+	return text
+}
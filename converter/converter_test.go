@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -63,7 +65,7 @@ func TestConvertBoldItalic(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := convertBoldItalic(tt.input)
+			result := convertBoldItalic(tt.input, ThemeNeutral)
 			if result != tt.expected {
 				t.Errorf("Expected %q, got %q", tt.expected, result)
 			}
@@ -71,6 +73,60 @@ func TestConvertBoldItalic(t *testing.T) {
 	}
 }
 
+func TestConvertHighlightUsesThemeColor(t *testing.T) {
+	tests := []struct {
+		theme string
+		want  string
+	}{
+		{"tieto", `<mark style="background-color:#f5ff56">key point</mark>`},
+		{"neutral", `<mark style="background-color:#fff3a3">key point</mark>`},
+		{"dark", `<mark style="background-color:#6b5900">key point</mark>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.theme, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Theme = tt.theme
+			result := Convert("A ==key point== here", config)
+			if result != "A "+tt.want+" here" {
+				t.Errorf("got %q, want highlight %q", result, tt.want)
+			}
+		})
+	}
+
+	if got := convertBoldItalic("==plain==", Theme{}); got != "<mark>plain</mark>" {
+		t.Errorf("theme without a highlight color: got %q, want a bare <mark>", got)
+	}
+}
+
+func TestConvertNamedHighlights(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain uses default", "==text==", `<mark style="background-color:#fff3a3">text</mark>`},
+		{"colon hint", "==green:text==", `<mark style="background-color:#d4f7dc">text</mark>`},
+		{"class hint", "=={.warn}text==", `<mark style="background-color:#ffe6b3">text</mark>`},
+		{"hint is case-insensitive", "==Red:text==", `<mark style="background-color:#ffd6d6">text</mark>`},
+		{"unknown name kept as text", "==Note: text==", `<mark style="background-color:#fff3a3">Note: text</mark>`},
+		{"unknown class kept as text", "=={.nope}text==", `<mark style="background-color:#fff3a3">{.nope}text</mark>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertBoldItalic(tt.input, ThemeNeutral); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	dark := convertBoldItalic("==green:text==", ThemeDark)
+	if dark != `<mark style="background-color:#1f5130">text</mark>` {
+		t.Errorf("dark theme named highlight: got %q", dark)
+	}
+}
+
 func TestConvertLinks(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -126,7 +182,7 @@ func TestConvertCode(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := convertCode(tt.input, theme)
+			result := convertCode(tt.input, theme, DefaultConfig())
 			if !strings.Contains(result, tt.contains) {
 				t.Errorf("Expected result to contain %q, got %q", tt.contains, result)
 			}
@@ -162,6 +218,85 @@ func TestConvertLists(t *testing.T) {
 	}
 }
 
+func TestConvertListsIndentation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Two-space indent",
+			input:    "- A\n  - B\n    - C",
+			expected: "* A\n** B\n*** C",
+		},
+		{
+			name:     "Four-space indent",
+			input:    "- A\n    - B\n        - C\n    - D",
+			expected: "* A\n** B\n*** C\n** D",
+		},
+		{
+			name:     "Tab indent",
+			input:    "1. A\n\t1. B\n\t\t1. C\n2. D",
+			expected: "# A\n## B\n### C\n# D",
+		},
+		{
+			name:     "Three-space indent under ordered items",
+			input:    "1. A\n   1. B",
+			expected: "# A\n## B",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := convertLists(tt.input); result != tt.expected {
+				t.Errorf("convertLists(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertListsMixedNesting(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Numbered sublist under bullet",
+			input:    "- A\n  1. B\n  2. C\n- D",
+			expected: "* A\n*# B\n*# C\n* D",
+		},
+		{
+			name:     "Bullet sublist under number",
+			input:    "1. A\n  - B\n    1. C\n2. D",
+			expected: "# A\n#* B\n#*# C\n# D",
+		},
+		{
+			name:     "Drop back two levels at once",
+			input:    "- A\n  - B\n    1. C\n- D",
+			expected: "* A\n** B\n**# C\n* D",
+		},
+		{
+			name:     "Switch type at top level",
+			input:    "- A\n1. B\n2. C",
+			expected: "* A\n# B\n# C",
+		},
+		{
+			name:     "Switch type at nested level",
+			input:    "- A\n  - B\n  1. C\n  - D",
+			expected: "* A\n** B\n*# C\n** D",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := convertLists(tt.input); result != tt.expected {
+				t.Errorf("convertLists(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestConvertTables(t *testing.T) {
 	input := `| Header 1 | Header 2 |
 | -------- | -------- |
@@ -218,6 +353,136 @@ func TestConvertCallouts(t *testing.T) {
 	}
 }
 
+func TestConvertBlockquotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Single line",
+			input:    "> Quoted text",
+			expected: "<blockquote>\nQuoted text\n</blockquote>",
+		},
+		{
+			name:     "Multi-line between paragraphs",
+			input:    "Before\n> Line one\n> Line two\nAfter",
+			expected: "Before\n<blockquote>\nLine one\nLine two\n</blockquote>\nAfter",
+		},
+		{
+			name:     "Nested",
+			input:    "> Outer\n>> Inner\n> Outer again",
+			expected: "<blockquote>\nOuter\n<blockquote>\nInner\n</blockquote>\nOuter again\n</blockquote>",
+		},
+		{
+			name:     "Inside code block untouched",
+			input:    "<syntaxhighlight lang=\"text\" line>\n> not a quote\n</syntaxhighlight>",
+			expected: "<syntaxhighlight lang=\"text\" line>\n> not a quote\n</syntaxhighlight>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := convertBlockquotes(tt.input); result != tt.expected {
+				t.Errorf("convertBlockquotes(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+// convertCalloutsReference is the original convertCallouts, which compiled
+// its patterns on every call. It pins the output of the cached version.
+func convertCalloutsReference(text string, theme Theme) string {
+	for calloutType, style := range theme.Callouts {
+		calloutRegex := regexp.MustCompile(fmt.Sprintf(`(?im)^>\s*\[!%s\]\s*\n?((?:>.*\n?)+)`, calloutType))
+		text = calloutRegex.ReplaceAllStringFunc(text, func(match string) string {
+			submatch := calloutRegex.FindStringSubmatch(match)
+			var cleanLines []string
+			for _, line := range strings.Split(submatch[1], "\n") {
+				cleaned := regexp.MustCompile(`^>\s?`).ReplaceAllString(line, "")
+				if strings.TrimSpace(cleaned) != "" || len(cleanLines) > 0 {
+					cleanLines = append(cleanLines, cleaned)
+				}
+			}
+			content := strings.TrimSpace(strings.Join(cleanLines, "<br/>"))
+			return fmt.Sprintf(`{| class="wikitable" style="border-left:4px solid %s; background-color:%s; width:100%%;"
+| <div style="padding:0.5em;">
+<strong style="color:%s;">%s %s:</strong><br/>%s
+</div>
+|}`, style.BorderColor, style.BgColor, style.TextColor, style.Emoji, style.Label, content)
+		})
+
+		singleLineRegex := regexp.MustCompile(fmt.Sprintf(`(?im)^>\s*\[!%s\]\s+(.+)$`, calloutType))
+		text = singleLineRegex.ReplaceAllStringFunc(text, func(match string) string {
+			content := strings.TrimSpace(singleLineRegex.FindStringSubmatch(match)[1])
+			return fmt.Sprintf(`{| class="wikitable" style="border-left:4px solid %s; background-color:%s; width:100%%;"
+| <div style="padding:0.5em;">
+<strong style="color:%s;">%s %s:</strong> %s
+</div>
+|}`, style.BorderColor, style.BgColor, style.TextColor, style.Emoji, style.Label, content)
+		})
+	}
+	return text
+}
+
+// calloutBenchmarkInput mixes multi-line and single-line callouts of every type.
+var calloutBenchmarkInput = strings.Repeat(`> [!NOTE]
+> First line
+> Second line
+
+> [!warning] Watch out
+
+Plain paragraph.
+
+> [!TIP]
+> Use the cache
+
+> [!important] Read this
+> [!success] Done
+> [!caution] Careful
+> [!info] FYI
+
+`, 20)
+
+func TestConvertCalloutsMatchesReference(t *testing.T) {
+	for _, theme := range []Theme{ThemeTieto, ThemeNeutral, ThemeDark} {
+		want := convertCalloutsReference(calloutBenchmarkInput, theme)
+		// Twice: once compiling the patterns, once from the cache.
+		for i := 0; i < 2; i++ {
+			if got := convertCallouts(calloutBenchmarkInput, theme); got != want {
+				t.Fatalf("theme %s pass %d: cached output differs from reference:\n%s\nwant\n%s", theme.Name, i, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkConvertCallouts(b *testing.B) {
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			convertCallouts(calloutBenchmarkInput, ThemeTieto)
+		}
+	})
+	b.Run("compile_per_call", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			convertCalloutsReference(calloutBenchmarkInput, ThemeTieto)
+		}
+	})
+}
+
+func TestConvertBlockquotesAfterCallouts(t *testing.T) {
+	input := "> [!NOTE]\n> This is a note\n\n> Plain quote"
+	result := Convert(input, Config{Theme: "tieto"})
+	if !strings.Contains(result, "📝 Note:") {
+		t.Errorf("callout not converted: %q", result)
+	}
+	if strings.Contains(result, "<blockquote>\n[!NOTE]") {
+		t.Errorf("callout was turned into a blockquote: %q", result)
+	}
+	if !strings.Contains(result, "<blockquote>\nPlain quote\n</blockquote>") {
+		t.Errorf("plain quote not converted: %q", result)
+	}
+}
+
 func TestConvertHorizontalRules(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -360,3 +625,246 @@ This is **bold** and *italic* text with `+"`inline code`"+`.
 		Convert(input, config)
 	}
 }
+
+func TestConvertMagicWordsPassThrough(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"NOTOC", "__NOTOC__"},
+		{"FORCETOC", "__FORCETOC__"},
+		{"NOEDITSECTION", "__NOEDITSECTION__"},
+		{"DISPLAYTITLE with underscores", "{{DISPLAYTITLE:my_page_title}}"},
+		{"DISPLAYTITLE with markup characters", "{{DISPLAYTITLE:<i>Some*Title</i>}}"},
+		{"DEFAULTSORT", "{{DEFAULTSORT:Smith, John}}"},
+		{"several on one line", "__NOTOC__ __NOEDITSECTION__"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input + "\n\n# Title\n\n- item with __bold__\n"
+			result := Convert(input, DefaultConfig())
+			if !strings.HasPrefix(result, tt.input+"\n") {
+				t.Errorf("magic word line changed: got %q", strings.SplitN(result, "\n", 2)[0])
+			}
+			if !strings.Contains(result, "* item with '''bold'''") {
+				t.Errorf("surrounding Markdown not converted: %q", result)
+			}
+		})
+	}
+}
+
+func TestConvertUnknownDoubleUnderscoreStillBold(t *testing.T) {
+	result := Convert("__NOTE__ read this", DefaultConfig())
+	if result != "'''NOTE''' read this" {
+		t.Errorf("got %q, want Markdown bold for a non-magic word", result)
+	}
+}
+
+func TestConvertPreservesHTMLComments(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+	}{
+		{"bold markers", "<!-- keep **this** and *that* -->"},
+		{"table pipes", "<!-- | not | a | table | -->"},
+		{"header hash", "<!-- # not a header -->"},
+		{"list markers", "<!--\n- not a list\n1. still not\n-->"},
+		{"magic word inside", "<!-- __NOTOC__ -->"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.comment + "\n\n## Title\n\nSome **bold** text " + tt.comment + "\n"
+			result := Convert(input, DefaultConfig())
+			if strings.Count(result, tt.comment) != 2 {
+				t.Errorf("comment not preserved verbatim: %q", result)
+			}
+			if !strings.Contains(result, "==Title==") || !strings.Contains(result, "'''bold'''") {
+				t.Errorf("surrounding Markdown not converted: %q", result)
+			}
+		})
+	}
+}
+
+func TestConvertPreservesHTMLCommentsWithEscaping(t *testing.T) {
+	config := DefaultConfig()
+	config.EscapeWikitext = true
+
+	comment := "<!-- see [[Other page]] and {{tmpl}} -->"
+	result := Convert(comment+"\n[[literal]]", config)
+	if !strings.HasPrefix(result, comment+"\n") {
+		t.Errorf("comment was escaped: %q", result)
+	}
+	if !strings.Contains(result, "<nowiki>[[</nowiki>") {
+		t.Errorf("prose outside the comment not escaped: %q", result)
+	}
+}
+
+func TestConvertEscapeWikitext(t *testing.T) {
+	config := DefaultConfig()
+	config.EscapeWikitext = true
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Literal template braces in prose",
+			input:    "Use {{name}} as a placeholder",
+			expected: "Use <nowiki>{{</nowiki>name}} as a placeholder",
+		},
+		{
+			name:     "Literal link brackets in prose",
+			input:    "Arrays like a[[0]] are nested",
+			expected: "Arrays like a<nowiki>[[</nowiki>0]] are nested",
+		},
+		{
+			name:     "Leading semicolon and colon",
+			input:    "; not a definition\n: not an indent",
+			expected: "<nowiki>;</nowiki> not a definition\n<nowiki>:</nowiki> not an indent",
+		},
+		{
+			name:     "Leading hash without space",
+			input:    "#hashtag in prose",
+			expected: "<nowiki>#</nowiki>hashtag in prose",
+		},
+		{
+			name:     "Real lists are not escaped",
+			input:    "- Item\n  - Nested\n1. First",
+			expected: "* Item\n** Nested\n# First",
+		},
+		{
+			name:     "Emphasis at line start is not escaped",
+			input:    "*Note* this",
+			expected: "''Note'' this",
+		},
+		{
+			name:     "Code blocks are not escaped",
+			input:    "```text\n{{keep}}\n; keep\n```",
+			expected: "<syntaxhighlight lang=\"text\" line>\n{{keep}}\n; keep\n</syntaxhighlight>",
+		},
+		{
+			name:     "Magic words survive",
+			input:    "{{DISPLAYTITLE:Title}}",
+			expected: "{{DISPLAYTITLE:Title}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Convert(tt.input, config); result != tt.expected {
+				t.Errorf("Convert(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if result := Convert("Use {{name}}", DefaultConfig()); result != "Use {{name}}" {
+		t.Errorf("escaping applied without EscapeWikitext: %q", result)
+	}
+}
+
+func TestConvertCodeTagOptions(t *testing.T) {
+	input := "```\n{\"key\": \"value\"}\n```"
+
+	tests := []struct {
+		name     string
+		modify   func(*Config)
+		expected string
+	}{
+		{
+			name:     "Default syntaxhighlight with line numbers",
+			modify:   func(*Config) {},
+			expected: "<syntaxhighlight lang=\"json\" line>\n{\"key\": \"value\"}\n</syntaxhighlight>",
+		},
+		{
+			name:     "Source tag",
+			modify:   func(c *Config) { c.CodeTag = CodeTagSource },
+			expected: "<source lang=\"json\" line>\n{\"key\": \"value\"}\n</source>",
+		},
+		{
+			name:     "Line numbers off",
+			modify:   func(c *Config) { c.ShowLineNumbers = false },
+			expected: "<syntaxhighlight lang=\"json\">\n{\"key\": \"value\"}\n</syntaxhighlight>",
+		},
+		{
+			name:     "Language detection off",
+			modify:   func(c *Config) { c.DetectLanguage = false },
+			expected: "<syntaxhighlight lang=\"text\" line>\n{\"key\": \"value\"}\n</syntaxhighlight>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			tt.modify(&config)
+			if result := Convert(input, config); result != tt.expected {
+				t.Errorf("Convert() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertSourceTagProtectsContent(t *testing.T) {
+	config := DefaultConfig()
+	config.CodeTag = CodeTagSource
+	config.EscapeWikitext = true
+	result := Convert("```text\n__init__ {{x}}\n> prompt\n```", config)
+	want := "<source lang=\"text\" line>\n__init__ {{x}}\n> prompt\n</source>"
+	if result != want {
+		t.Errorf("Convert() = %q, want %q", result, want)
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want string
+	}{
+		{"JSON", `{"key": "value"}`, "json"},
+		{"XML", `<root/>`, "xml"},
+		{"SQL", "select id from users", "sql"},
+		{"Go package", "package main\n\nfunc main() {}", "go"},
+		{"Go func", "func add(a, b int) int {\n\treturn a + b\n}", "go"},
+		{"Python def", "def greet(name):\n    print(name)", "python"},
+		{"Python import", "import os\nprint(os.getcwd())", "python"},
+		{"Python from-import is not SQL", "from pathlib import Path\nPath('.')", "python"},
+		{"Python shebang", "#!/usr/bin/env python3\nprint('hi')", "python"},
+		{"Bash shebang", "#!/bin/bash\necho hi", "bash"},
+		{"YAML", "name: app\nversion: 2\nenv:\n  - prod", "yaml"},
+		{"Plain text", "just some words", "text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectLanguage(tt.code, nil); got != tt.want {
+				t.Errorf("detectLanguage(%q) = %q, want %q", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectLanguageCustomHints(t *testing.T) {
+	config := DefaultConfig()
+	config.LanguageHints = []LanguageHint{
+		{Language: "powershell", Pattern: regexp.MustCompile(`(?m)^\$\w+ = |Get-\w+`)},
+		// Custom hints run first, so they can override a built-in guess.
+		{Language: "toml", Pattern: regexp.MustCompile(`(?m)^\[[\w.]+\]$`)},
+	}
+
+	if got := detectLanguage("Get-ChildItem -Path C:\\", config.LanguageHints); got != "powershell" {
+		t.Errorf("custom hint: got %q, want powershell", got)
+	}
+	if got := detectLanguage("[server]\nport = 8080", config.LanguageHints); got != "toml" {
+		t.Errorf("override of the JSON heuristic: got %q, want toml", got)
+	}
+	if got := detectLanguage("package main", config.LanguageHints); got != "go" {
+		t.Errorf("built-ins after custom hints: got %q, want go", got)
+	}
+
+	result := Convert("```\n$files = Get-ChildItem\n```", config)
+	if !strings.Contains(result, `lang="powershell"`) {
+		t.Errorf("Convert did not use the custom hint: %q", result)
+	}
+}
@@ -10,6 +10,11 @@ type Theme struct {
 	InlineCode  InlineCodeStyle         // Inline code styling
 	CodeBlock   CodeBlockStyle          // Code block styling
 	Callouts    map[string]CalloutStyle // Callout type -> styling
+	// HighlightColor is the background of ==highlighted== text
+	HighlightColor string
+	// Highlights maps highlight class names (==green:text==, =={.warn}text==)
+	// to background colors
+	Highlights map[string]string
 }
 
 // InlineCodeStyle defines styling for inline code (`code`)
@@ -65,6 +70,17 @@ var ThemeTieto = Theme{
 		BorderLeftColor: "#021e57", // Hero Blue accent
 		FontFamily:      "'Consolas', 'Monaco', 'Courier New', monospace",
 	},
+	HighlightColor: "#f5ff56", // Bright yellow (Tieto accent)
+	Highlights: map[string]string{
+		"yellow": "#f5ff56",
+		"green":  "#b7f5c2",
+		"blue":   "#c9d6ff",
+		"red":    "#ffc9c9",
+		"info":   "#c9d6ff",
+		"ok":     "#b7f5c2",
+		"warn":   "#ffe08a",
+		"error":  "#ffc9c9",
+	},
 	Callouts: map[string]CalloutStyle{
 		"note":      {"📝", "Note", "#839df9", "#f7f7fa", "#071d49"},
 		"info":      {"ℹ️", "Info", "#021e57", "#f7f7fa", "#021e57"},
@@ -94,6 +110,17 @@ var ThemeNeutral = Theme{
 		BorderLeftColor: "#ccc",
 		FontFamily:      "monospace",
 	},
+	HighlightColor: "#fff3a3",
+	Highlights: map[string]string{
+		"yellow": "#fff3a3",
+		"green":  "#d4f7dc",
+		"blue":   "#d6e9ff",
+		"red":    "#ffd6d6",
+		"info":   "#d6e9ff",
+		"ok":     "#d4f7dc",
+		"warn":   "#ffe6b3",
+		"error":  "#ffd6d6",
+	},
 	Callouts: map[string]CalloutStyle{
 		"note":      {"📝", "Note", "#0066cc", "#f0f7ff", "#003366"},
 		"info":      {"ℹ️", "Info", "#0066cc", "#f0f7ff", "#003366"},
@@ -130,6 +157,17 @@ var ThemeDark = Theme{
 		BorderLeftColor: "#7cb3ff",
 		FontFamily:      "monospace",
 	},
+	HighlightColor: "#6b5900", // Muted amber, readable under light text
+	Highlights: map[string]string{
+		"yellow": "#6b5900",
+		"green":  "#1f5130",
+		"blue":   "#1f3a66",
+		"red":    "#6b2323",
+		"info":   "#1f3a66",
+		"ok":     "#1f5130",
+		"warn":   "#6b4a0f",
+		"error":  "#6b2323",
+	},
 	Callouts: map[string]CalloutStyle{
 		"note":      {"📝", "Note", "#5c9aff", "#1a2744", "#a8c7ff"},
 		"info":      {"ℹ️", "Info", "#5c9aff", "#1a2744", "#a8c7ff"},
@@ -77,6 +77,9 @@ func TestGetThemeInlineAndCodeBlockStyles(t *testing.T) {
 		if theme.CodeBlock.BackgroundColor == "" || theme.CodeBlock.FontFamily == "" {
 			t.Errorf("theme %q code block style missing fields", themeName)
 		}
+		if theme.HighlightColor == "" {
+			t.Errorf("theme %q missing highlight color", themeName)
+		}
 	}
 }
 
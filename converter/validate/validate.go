@@ -0,0 +1,36 @@
+// Package validate checks converter output against a live wiki. It is kept
+// out of the converter package so that Convert itself has no dependency on
+// the wiki client.
+package validate
+
+import (
+	"context"
+
+	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
+)
+
+// Parser is the part of wiki.Client used for validation. *wiki.Client
+// satisfies it.
+type Parser interface {
+	ValidateWikitext(ctx context.Context, wikitext, title string) ([]wiki.WikitextIssue, error)
+}
+
+// Result reports whether converted wikitext parsed cleanly.
+type Result struct {
+	Valid  bool                 `json:"valid"`
+	Issues []wiki.WikitextIssue `json:"issues"`
+}
+
+// ValidateViaAPI submits wikitext (typically the output of converter.Convert)
+// to action=parse without saving it and returns the parser warnings, rendered
+// parser errors and unmatched template or table markup the wiki reported.
+func ValidateViaAPI(ctx context.Context, client Parser, wikitext string) (Result, error) {
+	issues, err := client.ValidateWikitext(ctx, wikitext, "")
+	if err != nil {
+		return Result{}, err
+	}
+	if issues == nil {
+		issues = []wiki.WikitextIssue{}
+	}
+	return Result{Valid: len(issues) == 0, Issues: issues}, nil
+}
@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/olgasafonova/mediawiki-mcp-server/converter"
+	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
+)
+
+// newParseServer returns a mock wiki whose action=parse reports warnings.
+func newParseServer(t *testing.T, warnings []string, gotText *string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		var resp map[string]interface{}
+		switch {
+		case r.FormValue("meta") == "userinfo":
+			resp = map[string]interface{}{"query": map[string]interface{}{
+				"userinfo": map[string]interface{}{"id": float64(1), "name": "TestUser"},
+			}}
+		case r.FormValue("action") == "parse":
+			*gotText = r.FormValue("text")
+			resp = map[string]interface{}{"parse": map[string]interface{}{
+				"text":          map[string]interface{}{"*": "<p>ok</p>"},
+				"parsewarnings": warnings,
+			}}
+		default:
+			resp = map[string]interface{}{}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func newTestClient(server *httptest.Server) *wiki.Client {
+	return wiki.NewClient(&wiki.Config{
+		BaseURL:    server.URL,
+		Username:   "TestUser",
+		Password:   "TestPass",
+		Timeout:    5 * time.Second,
+		MaxRetries: 1,
+		UserAgent:  "TestClient/1.0",
+	}, slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+}
+
+func TestValidateViaAPIReturnsWarnings(t *testing.T) {
+	var gotText string
+	server := newParseServer(t, []string{"Template loop detected: [[Template:Loop]]"}, &gotText)
+	defer server.Close()
+	client := newTestClient(server)
+	defer client.Close()
+
+	wikitext := converter.Convert("# Title\n**bold**", converter.DefaultConfig())
+	result, err := ValidateViaAPI(context.Background(), client, wikitext)
+	if err != nil {
+		t.Fatalf("ValidateViaAPI: %v", err)
+	}
+	if gotText != wikitext {
+		t.Errorf("parsed text = %q, want the converted wikitext %q", gotText, wikitext)
+	}
+	if result.Valid || len(result.Issues) != 1 {
+		t.Fatalf("result = %+v, want one issue", result)
+	}
+	if issue := result.Issues[0]; issue.Type != "parser_warning" || issue.Message != "Template loop detected: [[Template:Loop]]" {
+		t.Errorf("issue = %+v, want the parser warning", issue)
+	}
+}
+
+func TestValidateViaAPIClean(t *testing.T) {
+	var gotText string
+	server := newParseServer(t, nil, &gotText)
+	defer server.Close()
+	client := newTestClient(server)
+	defer client.Close()
+
+	result, err := ValidateViaAPI(context.Background(), client, "=Title=")
+	if err != nil {
+		t.Fatalf("ValidateViaAPI: %v", err)
+	}
+	if !result.Valid || result.Issues == nil || len(result.Issues) != 0 {
+		t.Errorf("result = %+v, want valid with an empty issue list", result)
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"os"
 	"os/signal"
 	"runtime/debug"
+	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/olgasafonova/mcp-servercard-go/servercard"
@@ -182,6 +184,18 @@ type ConvertMarkdownArgs struct {
 
 	// PrettifyChecks replaces plain checkmarks (✓) with emoji (✅)
 	PrettifyChecks *bool `json:"prettify_checks,omitempty" jsonschema:"Replace plain checkmarks with emoji ✅"`
+
+	// EscapeWikitext wraps literal wikitext syntax in prose in <nowiki>
+	EscapeWikitext *bool `json:"escape_wikitext,omitempty" jsonschema:"Escape literal [[, {{ and line-leading ; : # * in prose with <nowiki> (disables native [[wiki links]])"`
+
+	// CodeTag selects the code block tag: "syntaxhighlight" (default) or "source"
+	CodeTag string `json:"code_tag,omitempty" jsonschema:"Code block tag: 'syntaxhighlight' (default) or 'source' for older wikis"`
+
+	// LineNumbers controls the line attribute on code blocks (default true)
+	LineNumbers *bool `json:"line_numbers,omitempty" jsonschema:"Show line numbers in code blocks (default true)"`
+
+	// DetectLanguage guesses the language of unlabeled code blocks (default true)
+	DetectLanguage *bool `json:"detect_language,omitempty" jsonschema:"Guess the language of code blocks without one (default true); when false they use 'text'"`
 }
 
 // ConvertMarkdownResult contains the conversion output
@@ -213,6 +227,7 @@ type cliFlags struct {
 	allowedOrigins string
 	rateLimit      int
 	trustedProxies string
+	dryRun         bool
 }
 
 // parseFlags parses the command-line flags into a cliFlags value.
@@ -222,6 +237,7 @@ func parseFlags() cliFlags {
 	allowedOrigins := flag.String("origins", "", "Comma-separated allowed origins for CORS (e.g., 'https://chat.openai.com,https://n8n.example.com'). Empty allows all.")
 	rateLimit := flag.Int("rate-limit", 60, "Maximum requests per minute per IP (0 = unlimited)")
 	trustedProxies := flag.String("trusted-proxies", "", "Comma-separated trusted proxy IPs/CIDRs (e.g., '10.0.0.0/8,192.168.1.1'). Required to trust X-Forwarded-For header.")
+	dryRun := flag.Bool("dry-run", false, "Report what edit, move and upload tools would do without saving changes. Can also use MEDIAWIKI_DRY_RUN env var.")
 	flag.Parse()
 	return cliFlags{
 		httpAddr:       *httpAddr,
@@ -229,6 +245,7 @@ func parseFlags() cliFlags {
 		allowedOrigins: *allowedOrigins,
 		rateLimit:      *rateLimit,
 		trustedProxies: *trustedProxies,
+		dryRun:         *dryRun,
 	}
 }
 
@@ -260,8 +277,9 @@ func setupTracing(logger *slog.Logger) func() {
 }
 
 // loadConfigAndClient loads configuration, builds the wiki client, and wires up
-// client-level audit logging when configured.
-func loadConfigAndClient(logger *slog.Logger) (*wiki.Config, *wiki.Client) {
+// client-level audit logging when configured. dryRun (the -dry-run flag)
+// enables dry-run mode on top of MEDIAWIKI_DRY_RUN.
+func loadConfigAndClient(logger *slog.Logger, dryRun bool) (*wiki.Config, *wiki.Client) {
 	// Uses LoadConfigOrUnconfigured so the server starts even without
 	// MEDIAWIKI_URL, allowing MCP registries (Glama, Smithery) to inspect tool
 	// definitions. Tool calls return a clear error if the wiki URL is unset.
@@ -272,6 +290,15 @@ func loadConfigAndClient(logger *slog.Logger) (*wiki.Config, *wiki.Client) {
 	if !config.IsConfigured() {
 		logger.Warn("MEDIAWIKI_URL not set. Server will start in inspection mode: tools are listed but calls will fail until configured.")
 	}
+	if dryRun {
+		config.DryRun = true
+	}
+	if config.DryRun {
+		logger.Warn("Dry-run mode enabled: edits, moves and uploads will not be saved")
+	}
+	if config.IsConfigured() && config.HasGenericUserAgent() {
+		logger.Warn("Using the generic default User-Agent. Set MEDIAWIKI_CONTACT to an email or URL (or MEDIAWIKI_USER_AGENT to a descriptive value); public wikis such as Wikimedia may throttle or block clients without contact details.")
+	}
 
 	client := wiki.NewClient(config, logger)
 	if auditLogPath := os.Getenv("MEDIAWIKI_AUDIT_LOG"); auditLogPath != "" {
@@ -312,9 +339,28 @@ func newMCPServer(logger *slog.Logger) *mcp.Server {
 // registerToolsAndResources registers all wiki tools, the converter tool, and
 // the wiki resources. It returns a cleanup function for any audit logger.
 func registerToolsAndResources(server *mcp.Server, client *wiki.Client, logger *slog.Logger) func() {
-	registry := tools.NewHandlerRegistry(client, logger)
+	registry := tools.NewHandlerRegistry(client, logger).WithVersion(ServerVersion)
 	cleanup := func() {}
 
+	if v := os.Getenv("MEDIAWIKI_SLOW_CALL_THRESHOLD"); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			logger.Warn("Invalid MEDIAWIKI_SLOW_CALL_THRESHOLD, using default", "value", v, "default", tools.DefaultSlowCallThreshold)
+		} else {
+			registry.WithSlowCallThreshold(d)
+		}
+	}
+	if v := os.Getenv("MEDIAWIKI_TOOL_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			logger.Warn("Invalid MEDIAWIKI_TOOL_TIMEOUT, using default", "value", v, "default", tools.DefaultToolTimeout)
+		} else {
+			registry.WithToolTimeout(d)
+		}
+	}
+
+	if chunked, _ := strconv.ParseBool(os.Getenv("MEDIAWIKI_CHUNKED_RESULTS")); chunked {
+		registry.WithChunkedResults(true)
+	}
+
 	// Handler-level audit logging covers all tool calls, not just writes.
 	if auditLogPath := os.Getenv("MEDIAWIKI_AUDIT_LOG"); auditLogPath != "" {
 		toolAuditLogger, err := tools.NewFileToolAuditLogger(auditLogPath, logger)
@@ -399,7 +445,7 @@ func main() {
 	shutdownTracing := setupTracing(logger)
 	defer shutdownTracing()
 
-	config, client := loadConfigAndClient(logger)
+	config, client := loadConfigAndClient(logger, flags.dryRun)
 	authToken := resolveAuthToken(flags.bearerToken)
 
 	server := newMCPServer(logger)
@@ -324,6 +324,10 @@ OPTIONS:
 - add_css: Include CSS styling block for branded appearance
 - reverse_changelog: Reorder changelog entries newest-first
 - prettify_checks: Replace plain checkmarks with emoji
+- escape_wikitext: Escape literal [[, {{ and line-leading ; : # * in prose so they are not read as wikitext
+- code_tag: "syntaxhighlight" (default) or "source" for wikis with the old tag
+- line_numbers: Show line numbers in code blocks (default true)
+- detect_language: Guess the language of unlabeled code blocks (default true)
 
 EXAMPLE:
 Input: "# Hello\n**bold** and *italic*\n- item 1\n- item 2"
@@ -351,6 +355,18 @@ Output: "= Hello =\n'''bold''' and ''italic''\n* item 1\n* item 2"`,
 		if args.PrettifyChecks != nil {
 			config.PrettifyChecks = *args.PrettifyChecks
 		}
+		if args.EscapeWikitext != nil {
+			config.EscapeWikitext = *args.EscapeWikitext
+		}
+		if args.CodeTag != "" {
+			config.CodeTag = args.CodeTag
+		}
+		if args.LineNumbers != nil {
+			config.ShowLineNumbers = *args.LineNumbers
+		}
+		if args.DetectLanguage != nil {
+			config.DetectLanguage = *args.DetectLanguage
+		}
 
 		// Perform conversion
 		wikitext := converter.Convert(args.Markdown, config)
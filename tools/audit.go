@@ -170,6 +170,10 @@ func extractArgsSummary(args any) string {
 		return fmt.Sprintf("category=%s", a.Category)
 	case wiki.GetRevisionsArgs:
 		return fmt.Sprintf("title=%s", a.Title)
+	case wiki.GetDeletedRevisionsArgs:
+		return fmt.Sprintf("title=%s", a.Title)
+	case wiki.DetectEditWarsArgs:
+		return fmt.Sprintf("title=%s, since=%s", a.Title, a.Since)
 	case wiki.CompareRevisionsArgs:
 		return fmt.Sprintf("from_title=%s, to_title=%s", a.FromTitle, a.ToTitle)
 	case wiki.GetExternalLinksArgs:
@@ -178,12 +182,27 @@ func extractArgsSummary(args any) string {
 		return fmt.Sprintf("title=%s", a.Title)
 	case wiki.EditPageArgs:
 		return fmt.Sprintf("title=%s", a.Title)
+	case wiki.EditSectionArgs:
+		if a.Section == nil {
+			return fmt.Sprintf("title=%s", a.Title)
+		}
+		return fmt.Sprintf("title=%s, section=%d", a.Title, *a.Section)
 	case wiki.FindReplaceArgs:
 		return fmt.Sprintf("title=%s, preview=%t", a.Title, a.PreviewEnabled())
 	case wiki.ApplyFormattingArgs:
 		return fmt.Sprintf("title=%s, format=%s", a.Title, a.Format)
 	case wiki.BulkReplaceArgs:
 		return fmt.Sprintf("pages=%d, preview=%t", len(a.Pages), a.PreviewEnabled())
+	case wiki.NullEditPagesArgs:
+		return fmt.Sprintf("pages=%d", len(a.Pages))
+	case wiki.PublishMarkdownArgs:
+		return fmt.Sprintf("pages=%d, preview=%t", len(a.Pages), a.Preview)
+	case wiki.DeletePageArgs:
+		return fmt.Sprintf("title=%s", a.Title)
+	case wiki.UndeletePageArgs:
+		return fmt.Sprintf("title=%s, timestamps=%d", a.Title, len(a.Timestamps))
+	case wiki.ProtectPageArgs:
+		return fmt.Sprintf("title=%s, protections=%d", a.Title, len(a.Protections))
 	case wiki.FindSimilarPagesArgs:
 		return fmt.Sprintf("page=%s", a.Page)
 	case wiki.CompareTopicArgs:
@@ -193,6 +193,11 @@ func TestExtractArgsSummary(t *testing.T) {
 			args: wiki.EditPageArgs{Title: "Test Page", Content: "should not appear in summary"},
 			want: "title=Test Page",
 		},
+		{
+			name: "EditSectionArgs",
+			args: wiki.EditSectionArgs{Title: "Guide", Section: ptr(2), Content: "should not appear in summary"},
+			want: "title=Guide, section=2",
+		},
 		{
 			name: "FindReplaceArgs",
 			args: wiki.FindReplaceArgs{Title: "Release Notes", Preview: ptr(true)},
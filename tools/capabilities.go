@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"context"
+)
+
+// GetCapabilitiesArgs takes no parameters.
+type GetCapabilitiesArgs struct{}
+
+// GetCapabilitiesResult describes what the server can do at runtime.
+type GetCapabilitiesResult struct {
+	Version         string         `json:"version"`
+	ToolCount       int            `json:"tool_count"`
+	Categories      map[string]int `json:"categories"`
+	WriteOperations []string       `json:"write_operations"`
+	ReadOnly        bool           `json:"read_only"`
+	DryRun          bool           `json:"dry_run"`
+	AuditLogging    bool           `json:"audit_logging"`
+}
+
+// GetCapabilities reports the server's capabilities from the tools actually
+// registered, so it stays accurate after Reload narrows or widens the set.
+func (h *HandlerRegistry) GetCapabilities(_ context.Context, _ GetCapabilitiesArgs) (GetCapabilitiesResult, error) {
+	active := h.ActiveTools()
+	result := GetCapabilitiesResult{
+		Version:         h.version,
+		ToolCount:       len(active),
+		Categories:      make(map[string]int),
+		WriteOperations: []string{},
+		DryRun:          h.client.DryRun(),
+	}
+	for _, spec := range active {
+		result.Categories[spec.Category]++
+		if !spec.ReadOnly {
+			result.WriteOperations = append(result.WriteOperations, spec.Name)
+		}
+	}
+	result.ReadOnly = len(result.WriteOperations) == 0
+	_, nullAudit := h.auditLogger.(NullToolAuditLogger)
+	result.AuditLogging = !nullAudit
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
+)
+
+func TestGetCapabilitiesReflectsActiveTools(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := wiki.NewClient(&wiki.Config{DryRun: true}, logger)
+	defer client.Close()
+
+	registry := NewHandlerRegistry(client, logger).WithVersion("1.2.3")
+	registry.RegisterAll(mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil))
+
+	caps, err := registry.GetCapabilities(context.Background(), GetCapabilitiesArgs{})
+	if err != nil {
+		t.Fatalf("GetCapabilities: %v", err)
+	}
+	if caps.Version != "1.2.3" || caps.ToolCount != len(AllTools) || !caps.DryRun || caps.AuditLogging {
+		t.Errorf("GetCapabilities = %+v, want version 1.2.3, all tools, dry-run on, audit off", caps)
+	}
+	if caps.ReadOnly || len(caps.WriteOperations) == 0 {
+		t.Errorf("expected write operations with the full tool set, got %v", caps.WriteOperations)
+	}
+
+	var readOnly []ToolSpec
+	for _, spec := range AllTools {
+		if spec.ReadOnly {
+			readOnly = append(readOnly, spec)
+		}
+	}
+	if err := registry.Reload(readOnly); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	registry.WithAuditLogger(NewWriterToolAuditLogger(io.Discard, logger))
+
+	caps, err = registry.GetCapabilities(context.Background(), GetCapabilitiesArgs{})
+	if err != nil {
+		t.Fatalf("GetCapabilities after reload: %v", err)
+	}
+	if !caps.ReadOnly || len(caps.WriteOperations) != 0 || caps.ToolCount != len(readOnly) {
+		t.Errorf("after reload = %+v, want %d read-only tools and no write operations", caps, len(readOnly))
+	}
+	if caps.Categories["write"] != 0 {
+		t.Errorf("write category count = %d, want 0", caps.Categories["write"])
+	}
+	if !caps.AuditLogging {
+		t.Error("expected audit logging to be reported once a logger is set")
+	}
+}
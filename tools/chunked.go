@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
+)
+
+// resultChunks splits a large result into the parts sent as separate
+// content items in chunked mode: one per page for batch reads, and one per
+// check for a health audit, after a header with the remaining fields.
+// Results with no natural split return nil and are sent whole.
+// Type-asserted over reflection, like appendResultAttrs.
+func resultChunks(result any) []any {
+	switch r := result.(type) {
+	case wiki.GetPagesBatchResult:
+		header := r
+		header.Pages = nil
+		chunks := []any{header}
+		for _, page := range r.Pages {
+			chunks = append(chunks, page)
+		}
+		return chunks
+	case wiki.WikiHealthAuditResult:
+		header := r
+		header.BrokenLinks, header.Terminology, header.OrphanedPages = nil, nil, nil
+		header.ExternalLinks, header.RecentActivity = nil, nil
+		chunks := []any{header}
+		if r.BrokenLinks != nil {
+			chunks = append(chunks, r.BrokenLinks)
+		}
+		if r.Terminology != nil {
+			chunks = append(chunks, r.Terminology)
+		}
+		if r.OrphanedPages != nil {
+			chunks = append(chunks, r.OrphanedPages)
+		}
+		if r.ExternalLinks != nil {
+			chunks = append(chunks, r.ExternalLinks)
+		}
+		if r.RecentActivity != nil {
+			chunks = append(chunks, r.RecentActivity)
+		}
+		return chunks
+	}
+	return nil
+}
+
+// chunkedContent renders result as one text content item per chunk. It
+// returns nil when the result has fewer than two chunks, leaving the SDK's
+// default single item in place.
+func chunkedContent(result any) ([]mcp.Content, error) {
+	chunks := resultChunks(result)
+	if len(chunks) < 2 {
+		return nil, nil
+	}
+	content := make([]mcp.Content, 0, len(chunks))
+	for _, chunk := range chunks {
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, &mcp.TextContent{Text: string(data)})
+	}
+	return content, nil
+}
@@ -48,8 +48,10 @@ NOT FOR: Searching within a specific known page (use mediawiki_search_in_page in
 PARAMETERS:
 - query: Search text (required)
 - limit: Max results (default 20)
+- namespace: Only search this namespace (e.g. 0 = main, 10 = Template)
+- resolve_sections: Also report the section each match is in, for Page#Section links (first 10 hits only)
 
-RETURNS: Page titles, snippets with highlights, and relevance scores.`,
+RETURNS: Page titles, snippets with highlights, and relevance scores. With resolve_sections, each hit also has section_title and section_anchor.`,
 		ReadOnly:   true,
 		Idempotent: true,
 		OpenWorld:  true,
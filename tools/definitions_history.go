@@ -75,6 +75,27 @@ RETURNS: Recent changes with timestamps, users, and summaries. Aggregation retur
 		Idempotent: true,
 		OpenWorld:  true,
 	},
+	{
+		Name:     "mediawiki_get_recent_changes_feed",
+		Method:   "GetRecentChangesFeed",
+		Title:    "Get Recent Changes Feed",
+		Category: "history",
+		Description: `Export recent changes as an Atom or RSS feed document.
+
+USE WHEN: User asks "give me an RSS feed of wiki changes", "export recent changes as Atom".
+
+NOT FOR: Reading or summarizing changes (use mediawiki_get_recent_changes).
+
+PARAMETERS:
+- limit: Max changes (default 50)
+- namespace: Filter by namespace (-1 for all)
+- format: "atom" (default) or "rss"
+
+RETURNS: The feed XML with content type. Entry links point to the wiki pages.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
 	{
 		Name:     "mediawiki_get_revisions",
 		Method:   "GetRevisions",
@@ -113,8 +134,51 @@ PARAMETERS:
 - from_title: Source page title (uses latest revision)
 - to_rev: Target revision ID, OR
 - to_title: Target page title
+- format: "html" or "markdown" (fenced diff block with +/- lines; easier to read and quote). When omitted, pages with the json content model get a key-level JSON diff and other pages the HTML diff.
 
-RETURNS: HTML-formatted diff showing additions (green) and deletions (red).`,
+RETURNS: HTML-formatted diff showing additions (green) and deletions (red), a Markdown diff with lines added/removed counts, or (format "json") the added, removed and changed keys of a JSON page.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_detect_edit_wars",
+		Method:   "DetectEditWars",
+		Title:    "Detect Edit Wars",
+		Category: "history",
+		Description: `Flag pages where edits are repeatedly reverted in a short window.
+
+USE WHEN: User asks "are there edit wars", "which pages keep getting reverted", "find contested pages".
+
+NOT FOR: Reading one page's history (use mediawiki_get_revisions).
+
+PARAMETERS:
+- title: Check only this page (optional; default scans the busiest pages in recent changes)
+- since: Start of the window (ISO 8601, default 24 hours ago)
+- min_reverts: Reverts needed to flag a page (default 3)
+- namespace: Namespace for the recent changes scan (default 0, -1 for all)
+
+RETURNS: Suspected edit wars with revert count, participants, and the reverting revision IDs. A revert is an edit restoring an earlier revision's exact content, or exactly cancelling the size change of the previous edit by another user.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_get_deleted_revisions",
+		Method:   "GetDeletedRevisions",
+		Title:    "Get Deleted Revisions",
+		Category: "history",
+		Description: `List the deleted revisions of a page (admin only).
+
+USE WHEN: An administrator asks "what was deleted from X", "show deleted history before undeleting", "which revisions can be restored".
+
+NOT FOR: Live page history (use mediawiki_get_revisions).
+
+PARAMETERS:
+- title: Page name (required)
+- limit: Max deleted revisions (default 20, max 100)
+
+RETURNS: Deleted revisions with timestamps, users, sizes, and edit summaries. Requires the 'deletedhistory' right; accounts without it get a permission error.`,
 		ReadOnly:   true,
 		Idempotent: true,
 		OpenWorld:  true,
@@ -246,6 +310,27 @@ RETURNS: Broken links with source page, line number, and context.`,
 		Idempotent: true,
 		OpenWorld:  true,
 	},
+	{
+		Name:     "mediawiki_find_missing_modules",
+		Method:   "FindMissingModules",
+		Title:    "Find Missing Modules",
+		Category: "links",
+		Description: `Find {{#invoke:Module|function}} calls to Lua modules that do not exist.
+
+USE WHEN: User asks "find script errors", "which modules are missing", "check #invoke calls" on a Scribunto wiki.
+
+NOT FOR: Broken [[links]] to ordinary pages (use mediawiki_find_broken_internal_links).
+
+PARAMETERS:
+- pages: Array of pages to scan (optional)
+- category: Scan all pages in category (optional)
+- limit: Max pages to scan (default 20)
+
+RETURNS: Per page, the missing Module: titles with line number and context.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
 	{
 		Name:     "mediawiki_find_orphaned_pages",
 		Method:   "FindOrphanedPages",
@@ -265,4 +350,63 @@ RETURNS: List of orphaned page titles.`,
 		Idempotent: true,
 		OpenWorld:  true,
 	},
+	{
+		Name:     "mediawiki_find_dead_end_pages",
+		Method:   "FindDeadEndPages",
+		Title:    "Find Dead-End Pages",
+		Category: "links",
+		Description: `Find pages that contain no links to other wiki pages.
+
+USE WHEN: User asks "find dead-end pages", "which pages link nowhere", "find stubs that need cross-linking".
+
+NOT FOR: Pages nothing links to (use mediawiki_find_orphaned_pages).
+
+PARAMETERS:
+- namespace: Filter by namespace (default 0 = main, -1 = all)
+- prefix: Filter by title prefix (optional)
+- limit: Max pages to return (default 50)
+
+RETURNS: Dead-end pages with page ID, length, and last edit time.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_get_most_linked_pages",
+		Method:   "GetMostLinkedPages",
+		Title:    "Get Most Linked Pages",
+		Category: "links",
+		Description: `List the pages with the most incoming links.
+
+USE WHEN: User asks "what are the most important pages", "most linked pages", "which pages should be kept up to date first".
+
+PARAMETERS:
+- namespace: Filter by namespace (default 0 = main, -1 = all)
+- limit: Max pages to return (default 50)
+
+RETURNS: Page titles with incoming-link counts, most linked first.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_get_wanted_pages",
+		Method:   "GetWantedPages",
+		Title:    "Get Wanted Pages",
+		Category: "links",
+		Description: `List pages that do not exist yet but are linked to (red links), with how many links point at each.
+
+USE WHEN: User asks "what pages should we create", "most wanted pages", "which red links matter most".
+
+NOT FOR: Broken links on specific pages (use mediawiki_find_broken_internal_links).
+
+PARAMETERS:
+- namespace: Filter by namespace (default 0 = main, -1 = all)
+- limit: Max pages to return (default 50)
+
+RETURNS: Missing page titles with incoming-link counts, most wanted first. Pages with many links are prime candidates to create.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
 }
@@ -26,6 +26,29 @@ RETURNS: Violations with page, line, wrong term, and correct term.`,
 		Idempotent: true,
 		OpenWorld:  true,
 	},
+	{
+		Name:     "mediawiki_check_spelling",
+		Method:   "CheckSpelling",
+		Title:    "Check Spelling",
+		Category: "quality",
+		Description: `Check pages against a team spelling dictionary (allowlist and denylist).
+
+USE WHEN: User asks "check spelling against our dictionary", "find words we don't use", "is JavaScript spelled consistently".
+
+NOT FOR: Brand or product naming rules with patterns (use mediawiki_check_terminology).
+
+PARAMETERS:
+- pages: Array of pages to check (optional)
+- category: Check all pages in category (optional)
+- dictionary_page: Wiki page with a word table: | word || allow/deny || suggestion | (default "Spelling Dictionary")
+- limit: Max pages (default 10)
+- max_issues_per_page: Max issues listed per page (default 100); pages with more are marked truncated
+
+RETURNS: Denylisted words, words missing from the allowlist (type "unknown"; common stopwords are exempt), and allowlisted words written with a different spelling or capitalization, with page, line, suggestion, and context. Code blocks and markup (link targets, template names and parameters, tags, URLs) are skipped.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
 	{
 		Name:     "mediawiki_check_translations",
 		Method:   "CheckTranslations",
@@ -64,13 +87,136 @@ PARAMETERS:
   - "activity": Recent changes
   - "external": Broken external links (slow)
 - limit: Max items per check (default 20)
+- explain_only: Return the plan (checks, resolved pages, estimated API calls) without running it
+
+RETURNS: Health score (0-100), detailed results per check, and recommendations; or, with explain_only, the audit plan.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_find_inlined_template_content",
+		Method:   "FindInlinedTemplateContent",
+		Title:    "Find Inlined Template Content",
+		Category: "quality",
+		Description: `Find pages that paste a template's content instead of using the template.
+
+USE WHEN: User asks "which pages copy the notice box instead of using the template", "find pasted boilerplate", "where is {{X}} inlined".
+
+PARAMETERS:
+- template_name: Template to look for (required, with or without 'Template:' prefix)
+- pages: Array of pages to check (optional)
+- category: Check all pages in category (optional)
+- limit: Max pages (default 10)
+
+RETURNS: Pages containing the template's expanded text inline, each with a suggestion to use {{TemplateName}} instead. Whitespace differences are ignored.
+
+NOTE: Templates whose expanded text is very short are rejected, since they would match ordinary prose.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_find_stale_references",
+		Method:   "FindStaleReferences",
+		Title:    "Find Stale References",
+		Category: "quality",
+		Description: `Find hardcoded dates and version numbers that are likely out of date.
+
+USE WHEN: User asks "find outdated dates", "which pages say 'as of 2022'", "find hardcoded version numbers".
+
+NOT FOR: Pages that simply haven't been edited recently (use mediawiki_get_stale_pages).
+
+PARAMETERS:
+- pages: Array of pages to check (optional)
+- category: Check all pages in category (optional)
+- patterns: Regexes replacing the defaults (optional). A named group 'year' limits matches to past years.
+- limit: Max pages (default 10)
+
+RETURNS: Matches with page, line, matched text, and context for human review. Defaults flag past 'as of <year>' phrases, copyright years, and version strings. Code blocks are skipped.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+
+	{
+		Name:     "mediawiki_get_citations",
+		Method:   "GetCitations",
+		Title:    "Get Citations",
+		Category: "quality",
+		Description: `List the <ref> citations on a page with how often each is used.
+
+USE WHEN: User asks "what sources does this page cite", "list the references on X", "which refs are broken".
+
+NOT FOR: External link health (use mediawiki_check_links). Duplicate refs (use mediawiki_find_duplicate_citations).
+
+PARAMETERS:
+- title: Page title (required)
 
-RETURNS: Health score (0-100), detailed results per check, and recommendations.`,
+RETURNS: Each distinct citation with name, group, body, and use count. Named refs that are reused but never defined are flagged as undefined. Refs in comments, nowiki, and code blocks are ignored.`,
 		ReadOnly:   true,
 		Idempotent: true,
 		OpenWorld:  true,
 	},
+	{
+		Name:     "mediawiki_find_duplicate_citations",
+		Method:   "FindDuplicateCitations",
+		Title:    "Find Duplicate Citations",
+		Category: "quality",
+		Description: `Find citations on a page whose body is defined more than once.
+
+USE WHEN: User asks "clean up the references", "find duplicate refs", "merge repeated citations".
+
+NOT FOR: Listing every citation (use mediawiki_get_citations).
+
+PARAMETERS:
+- title: Page title (required)
 
+RETURNS: Citation bodies defined under several names or repeated inline, with the names, inline count, total uses, and a suggestion for merging them into one named ref. Bodies are compared ignoring whitespace differences.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_get_template_usage_stats",
+		Method:   "GetTemplateUsageStats",
+		Title:    "Get Template Usage Stats",
+		Category: "quality",
+		Description: `Report how many pages transclude each template, most used first.
+
+USE WHEN: User asks "which templates are unused", "can we deprecate this template", "most used templates".
+
+NOT FOR: Pages that copy a template's text instead of transcluding it (use mediawiki_find_inlined_template_content).
+
+PARAMETERS:
+- namespace: Only count transclusions from this namespace, e.g. 0 for articles (optional, default all)
+- limit: Max templates to scan (default 50, max 200)
+
+RETURNS: Templates with usage counts sorted descending, including unused ones (count 0). Counting stops at 5000 uses per template (marked capped). has_more is true when the wiki has more templates than were scanned.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_get_infobox_templates",
+		Method:   "GetInfoboxTemplates",
+		Title:    "Get Infobox Templates",
+		Category: "quality",
+		Description: `List the wiki's infobox templates (names starting with "Infobox") with how many pages use each.
+
+USE WHEN: User asks "which infoboxes does this wiki use", "what infobox should this page have", "most used infobox".
+
+NOT FOR: Usage counts of all templates (use mediawiki_get_template_usage_stats).
+
+PARAMETERS:
+- namespace: Only count transclusions from this namespace, e.g. 0 for articles (optional, default all)
+- limit: Max infobox templates to scan (default 50, max 200)
+
+RETURNS: Infobox templates with usage counts sorted descending, including unused ones. has_more is true when more infobox templates exist than were scanned.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
 	// ==========================================================================
 	// DISCOVERY TOOLS
 	// ==========================================================================
@@ -19,6 +19,7 @@ NOT FOR: Getting page structure/TOC (use mediawiki_get_sections). Not for search
 PARAMETERS:
 - title: Page name (required)
 - format: "wikitext" (default) or "html"
+- variant: Language variant on multi-script wikis, e.g. "zh-hant" or "sr-el" (returns converted HTML)
 
 RETURNS: Page content in requested format. Large pages truncated at 25KB.`,
 		ReadOnly:   true,
@@ -136,10 +137,52 @@ RETURNS: Image titles, URLs, dimensions, and file sizes.`,
 USE WHEN: User wants to preview wikitext rendering, test markup syntax.
 
 PARAMETERS:
-- wikitext: Wikitext content to parse (required)
-- title: Context page title for link resolution (optional)
+- wikitext: Wikitext content to parse (required unless title is given)
+- title: Context page title for link resolution; without wikitext, the page itself is parsed
+- props: Parts to return: text, categories, links, sections, wikitext, headhtml (default text, categories, links)
+- section: Parse only this section (0 = intro), keeping the full page's heading anchors
 
-RETURNS: Rendered HTML output.`,
+RETURNS: Rendered HTML output, display title, and any requested props.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_preview_edit",
+		Method:   "PreviewEdit",
+		Title:    "Preview Edit",
+		Category: "read",
+		Description: `Render page content exactly as it would look once saved, without saving.
+
+USE WHEN: Before mediawiki_edit_page, to check the result visually: "show me how this will look", "preview my changes".
+
+NOT FOR: Checking what text a find/replace would change (use preview on mediawiki_find_replace). Not for rendering arbitrary snippets (use mediawiki_parse).
+
+PARAMETERS:
+- title: Page the content would be saved to (required)
+- content: Full wikitext that would be saved (required)
+
+RETURNS: Sanitized rendered HTML, the categories the page would be in, and parser warnings (template loops, expansion limits). Signatures and subst: are expanded as on save. Nothing is saved.`,
+		ReadOnly:   true,
+		Idempotent: true,
+		OpenWorld:  true,
+	},
+	{
+		Name:     "mediawiki_expand_templates",
+		Method:   "ExpandTemplates",
+		Title:    "Expand Templates",
+		Category: "read",
+		Description: `Expand all templates, parser functions and magic words in a page or in raw wikitext.
+
+USE WHEN: User asks "what does this template produce", "why does this page render like that", "show the page with templates expanded".
+
+NOT FOR: Rendered HTML (use mediawiki_parse).
+
+PARAMETERS:
+- title: Page to expand (fetches its current wikitext); with wikitext, only sets the page context
+- wikitext: Raw wikitext to expand (alternative to title)
+
+RETURNS: Fully expanded wikitext.`,
 		ReadOnly:   true,
 		Idempotent: true,
 		OpenWorld:  true,
@@ -155,9 +198,26 @@ USE WHEN: User asks "what wiki is this", "wiki statistics", "MediaWiki version".
 
 PARAMETERS: None
 
-RETURNS: Wiki name, version, statistics (pages, users, edits).`,
+RETURNS: Wiki name, version, statistics (pages, users, edits), and the language variants pages can be converted to on multi-script wikis.`,
 		ReadOnly:   true,
 		Idempotent: true,
 		OpenWorld:  true,
 	},
+	{
+		Name:     "mediawiki_get_capabilities",
+		Method:   "GetCapabilities",
+		Title:    "Get Server Capabilities",
+		Category: "read",
+		Description: `Describe what this MCP server can do right now.
+
+USE WHEN: Before planning edits, to check "can you edit the wiki", "is this server read-only", "is dry-run on", "which write tools are available".
+
+NOT FOR: Information about the wiki itself (use mediawiki_get_wiki_info).
+
+PARAMETERS: None
+
+RETURNS: Server version, active tool count, enabled write operations, read-only and dry-run state, and whether audit logging is on. Reflects the tools currently registered, not a static list.`,
+		ReadOnly:   true,
+		Idempotent: true,
+	},
 }
@@ -19,18 +19,74 @@ NOT FOR: Simple text changes (use mediawiki_find_replace). Not for formatting (u
 
 PARAMETERS:
 - title: Page name (required)
-- content: New page content (required)
+- content: New page content (required unless append_text or prepend_text is set)
+- append_text / prepend_text: Add text to the end / start of the page (or section) instead of replacing it. Merged by the wiki, so safe for log and changelog lines under concurrent edits. Cannot be combined with content
 - section: Edit specific section only (optional)
 - summary: Edit summary (required for good practice)
 - minor: Mark as minor edit (default false)
 - bot: Mark as bot edit (default false)
 - base_timestamp: Revision timestamp from mediawiki_get_page (optional, recommended). When set, the edit fails with 'editconflict' if someone else changed the page since that revision, instead of silently overwriting their edit. On conflict: re-read with mediawiki_get_page and reapply.
+- validate_first: Parse-check the wikitext before saving and refuse the edit if broken templates, tables, or parser errors are found (default false)
+- allow_blanking: Allow emptying the page or removing more than 90% of its content (default false; such edits are refused as likely mistakes)
 
 RETURNS: Includes revision ID, diff URL, and undo instructions.
 
 NOTE: Requires authentication (bot password). Anonymous sessions cannot edit.
 
-WARNING: This overwrites entire page content unless section is specified.`,
+WARNING: This overwrites entire page content unless section, append_text or prepend_text is specified.`,
+		ReadOnly:    false,
+		Destructive: true,
+		Idempotent:  false,
+		OpenWorld:   true,
+	},
+	{
+		Name:     "mediawiki_edit_section",
+		Method:   "EditSection",
+		Title:    "Edit Section",
+		Category: "write",
+		Description: `Replace the content of ONE section of an existing page.
+
+USE WHEN: User says "rewrite the Installation section", "update the intro", "replace the FAQ section" on a large page.
+
+NOT FOR: Whole-page rewrites or new pages (use mediawiki_edit_page). Not for small text changes (use mediawiki_find_replace).
+
+PARAMETERS:
+- title: Page name (required)
+- section: Section index from mediawiki_get_sections (required; 0 = intro)
+- content: Replacement wikitext for the section, including its heading line (required)
+- summary: Edit summary
+- minor: Mark as minor edit (default false)
+- base_revid: Revision ID the edit is based on (optional, recommended). When set, the edit fails with 'editconflict' if the section changed since that revision.
+
+RETURNS: Includes revision ID and page URL.
+
+NOTE: Requires authentication (bot password). Only the targeted section is sent, so concurrent edits to other sections are preserved. Fails if the page does not exist.`,
+		ReadOnly:    false,
+		Destructive: true,
+		Idempotent:  false,
+		OpenWorld:   true,
+	},
+	{
+		Name:     "mediawiki_move_section",
+		Method:   "MoveSection",
+		Title:    "Move Section",
+		Category: "write",
+		Description: `Move ONE section from one page to another existing page.
+
+USE WHEN: User says "move the FAQ section to the FAQ page", "split this section out into page X", "relocate section Y".
+
+NOT FOR: Renaming a whole page (use mediawiki_move_page). Not for editing a section in place (use mediawiki_edit_section).
+
+PARAMETERS:
+- from_title: Page to take the section from (required)
+- from_section: Section index from mediawiki_get_sections (required; 1+, the intro cannot be moved)
+- to_title: Existing page to move the section to (required)
+- position: 'append' (default) or 'prepend' on the target page
+- summary: Edit summary for both edits (auto-generated if empty)
+
+RETURNS: Revision IDs of the target and source edits.
+
+NOTE: Requires authentication (bot password). Makes two edits: the section is appended or prepended to the target first (merged by the wiki, so concurrent target edits are kept), then removed from the source. If the source changed since the section was read, the removal fails with an edit conflict rather than removing a different section. If the second edit fails, partial=true is returned and the section exists on both pages.`,
 		ReadOnly:    false,
 		Destructive: true,
 		Idempotent:  false,
@@ -127,6 +183,59 @@ NOTE: Requires authentication (bot password) to apply changes. Anonymous session
 		Idempotent:  false,
 		OpenWorld:   true,
 	},
+	{
+		Name:     "mediawiki_null_edit_pages",
+		Method:   "NullEditPages",
+		Title:    "Null Edit Pages",
+		Category: "write",
+		Description: `Re-save pages without changing their content (a "null edit") so MediaWiki re-parses them.
+
+USE WHEN: After a template change, categories or links on the pages that use it are stale and a purge did not refresh them. "Refresh the category membership", "touch these pages".
+
+NOT FOR: Changing content (use mediawiki_edit_page or mediawiki_bulk_replace).
+
+PARAMETERS:
+- pages: Page titles to null-edit (required, max 200)
+
+BEHAVIOR: Existence is checked in chunks of 50 and each page is saved with an empty append, pausing briefly between saves. No content is sent, so edits made in the meantime are never reverted. No revision is created and page history is unchanged. Missing pages are reported as failures and never created.
+
+RETURNS: Per-page success or error, with success and failure counts.
+
+NOTE: Requires authentication (bot password). In dry-run mode nothing is saved.`,
+		ReadOnly:    false,
+		Destructive: false,
+		Idempotent:  true,
+		OpenWorld:   true,
+	},
+	{
+		Name:     "mediawiki_publish_markdown",
+		Method:   "PublishMarkdown",
+		Title:    "Publish Markdown Pages",
+		Category: "write",
+		Description: `Convert a batch of Markdown documents to MediaWiki markup and create a wiki page for each.
+
+USE WHEN: User says "import these Markdown files", "publish this docs folder to the wiki", "create pages from these READMEs".
+
+NOT FOR: Converting without saving a single document (use mediawiki_convert_markdown). Not for editing existing pages (use mediawiki_edit_page).
+
+PARAMETERS:
+- pages: Array of {title, markdown} (required, max 50)
+- theme: Converter color theme: 'tieto', 'neutral' (default), or 'dark'
+- summary: Edit summary for every page (default "Imported from Markdown")
+- preview: Convert only and return the wikitext for review, without saving (default false)
+- overwrite: Replace pages that already exist (default false; existing pages are left untouched and reported as failures)
+
+RETURNS: Per-page success or error with revision ID and page URL, plus success and failure counts. A failure on one page does not stop the others.
+
+NOTE: Requires authentication (bot password). Each page is saved through mediawiki_edit_page, so its guardrails apply. In dry-run mode nothing is saved; results are marked dry_run.
+
+WARNING: With overwrite set, existing page content is replaced entirely.`,
+		ReadOnly:    false,
+		Destructive: true,
+		Idempotent:  false,
+		OpenWorld:   true,
+	},
+
 	// ==========================================================================
 	// BATCH TOOLS (Performance)
 	// ==========================================================================
@@ -162,9 +271,9 @@ USE WHEN: You need info (last edit, size, categories) for 2+ pages without their
 NOT FOR: Single page (use mediawiki_get_page_info). Not for content (use mediawiki_batch_get_pages).
 
 PARAMETERS:
-- titles: Array of page titles (required, max 50)
+- titles: Array of page titles (required, max 500; fetched 50 per API request)
 
-RETURNS: Metadata (size, last edit, categories, protection) per page. Missing pages reported with exists=false.`,
+RETURNS: Metadata (size, last edit, categories, protection) per page. Missing pages reported with exists=false. errors maps each missing or failed title to the reason.`,
 		ReadOnly:   true,
 		Idempotent: true,
 		OpenWorld:  true,
@@ -236,7 +345,7 @@ PARAMETERS:
 - move_talk: Also move the talk page (default true)
 - move_subpages: Also move subpages (default false)
 
-RETURNS: Includes revision ID, diff URL, and undo instructions.
+RETURNS: Old and new titles, whether a redirect was created, whether the talk page moved, and any API warnings. Fails with an articleexists error if the target title is taken.
 
 WARNING: Requires authentication (bot password) and move permissions. Creates a redirect from the old title by default.`,
 		ReadOnly:    false,
@@ -244,6 +353,79 @@ WARNING: Requires authentication (bot password) and move permissions. Creates a
 		Idempotent:  false,
 		OpenWorld:   true,
 	},
+	{
+		Name:     "mediawiki_delete_page",
+		Method:   "DeletePage",
+		Title:    "Delete Page",
+		Category: "write",
+		Description: `Delete a wiki page with all its revisions. Deleted pages can be restored with mediawiki_undelete_page.
+
+USE WHEN: User says "delete the page", "remove this spam page", "clean up the test page".
+
+NOT FOR: Renaming (use mediawiki_move_page). Blanking content (use mediawiki_edit_page).
+
+PARAMETERS:
+- title: Page to delete (required)
+- reason: Reason shown in the deletion log (optional)
+
+RETURNS: Deleted title, deletion log ID, and how many revisions were deleted (revisions_capped is set when a very long history was only counted up to 50,000).
+
+WARNING: Requires authentication (bot password) and the 'delete' right (usually sysop).`,
+		ReadOnly:    false,
+		Destructive: true,
+		Idempotent:  false,
+		OpenWorld:   true,
+	},
+	{
+		Name:     "mediawiki_undelete_page",
+		Method:   "UndeletePage",
+		Title:    "Undelete Page",
+		Category: "write",
+		Description: `Restore a deleted wiki page, either fully or only selected revisions.
+
+USE WHEN: User says "restore the deleted page", "undo the deletion", "bring back revision X".
+
+NOT FOR: Reverting an edit on a live page (use mediawiki_edit_page with older content).
+
+PARAMETERS:
+- title: Deleted page to restore (required)
+- reason: Reason shown in the deletion log (optional)
+- timestamps: Timestamps of the deleted revisions to restore, from mediawiki_get_deleted_revisions (optional, default all)
+
+RETURNS: Restored title, number of revisions and file versions restored.
+
+WARNING: Requires authentication (bot password) and the 'undelete' right (usually sysop).`,
+		ReadOnly:    false,
+		Destructive: false,
+		Idempotent:  false,
+		OpenWorld:   true,
+	},
+	{
+		Name:     "mediawiki_protect_page",
+		Method:   "ProtectPage",
+		Title:    "Protect Page",
+		Category: "write",
+		Description: `Set or lift protection on a wiki page, per action (edit, move, upload, create).
+
+USE WHEN: User says "lock the page", "protect from vandalism", "only admins should edit this", "unprotect the page".
+
+NOT FOR: Checking current protection (use mediawiki_get_page_info).
+
+PARAMETERS:
+- title: Page to protect (required)
+- protections: Level per action, e.g. {"edit": "sysop", "move": "autoconfirmed"}; "all" lifts protection (required)
+- expiry: Timestamp or relative time like "1 week" (default infinite)
+- reason: Reason shown in the protection log (optional)
+- cascade: Also protect transcluded pages (optional)
+
+RETURNS: Protection before and after the change, in the same "action: level" form as mediawiki_get_page_info, plus each applied protection with its expiry.
+
+WARNING: Requires authentication (bot password) and the 'protect' right (usually sysop).`,
+		ReadOnly:    false,
+		Destructive: true,
+		Idempotent:  true,
+		OpenWorld:   true,
+	},
 	{
 		Name:     "mediawiki_manage_categories",
 		Method:   "ManageCategories",
@@ -270,6 +452,57 @@ NOTE: Requires authentication (bot password) to apply changes. Anonymous session
 		Idempotent:  false,
 		OpenWorld:   true,
 	},
+	{
+		Name:     "mediawiki_recategorize_pages",
+		Method:   "RecategorizePages",
+		Title:    "Recategorize Pages",
+		Category: "write",
+		Description: `Move every member of a category to a new category (category rename).
+
+USE WHEN: User says "rename category X to Y", "move all pages from category X to Y", "recategorize these pages".
+
+NOT FOR: Adding or removing categories on one page (use mediawiki_manage_categories). Not for arbitrary text changes (use mediawiki_bulk_replace).
+
+PARAMETERS:
+- old_category: Current category name (required)
+- new_category: New category name (required)
+- preview: Preview changes without saving. Omit to preview (default true) — ALWAYS preview first; set preview=false to apply.
+- limit: Max member pages to process (default 10, max 50)
+- summary: Edit summary (auto-generated if empty)
+
+RETURNS: Per-page changes. Sort keys ([[Category:X|key]]) are preserved. Includes revision ID, diff URL, and undo instructions.
+
+NOTE: Requires authentication (bot password) to apply changes. Pages categorized through a template are reported with zero matches and must be fixed in the template. The category page itself is not moved (use mediawiki_move_page).`,
+		ReadOnly:    false,
+		Destructive: true,
+		Idempotent:  false,
+		OpenWorld:   true,
+	},
+	{
+		Name:     "mediawiki_add_category_to_pages",
+		Method:   "AddCategoryToPages",
+		Title:    "Add Category To Pages",
+		Category: "write",
+		Description: `Add one category to a list of pages, skipping pages that already have it.
+
+USE WHEN: User says "add these pages to category X", "tag all of these with category X".
+
+NOT FOR: Adding several categories to one page (use mediawiki_manage_categories). Not for renaming a category (use mediawiki_recategorize_pages).
+
+PARAMETERS:
+- pages: Page titles to tag (required, max 50)
+- category: Category name to add (required)
+- preview: Preview changes without saving. Omit to preview (default true); set preview=false to apply.
+- summary: Edit summary (auto-generated if empty)
+
+RETURNS: Per-page status (added, would_add, already_present, error) with revision ID and undo instructions for saved edits.
+
+NOTE: Requires authentication (bot password) to apply changes. Existing tags are matched the way MediaWiki does (case of the first letter, spaces vs underscores, sort keys). The tag is appended without resending the page text; a page edited since it was read fails with an edit conflict, and pages too large to read in full are refused.`,
+		ReadOnly:    false,
+		Destructive: false,
+		Idempotent:  true,
+		OpenWorld:   true,
+	},
 
 	// ==========================================================================
 	// WIKI HYGIENE TOOLS
@@ -316,7 +549,7 @@ PARAMETERS:
 
 RETURNS: Upload status and file page URL. Includes revision ID, diff URL, and undo instructions.
 
-NOTE: Requires authentication. For file_url, the URL must be publicly accessible.
+NOTE: Requires authentication. For file_url, the URL must be publicly accessible. For file_data, if the target file already has identical content (same SHA-1) the upload is skipped and already_present is returned, so retrying a failed upload is safe.
 
 SECURITY: file_data uploads bytes directly and never triggers a server-side fetch, so the allowlist/SSRF gates do not apply to that path. For file_url, the source host must be on the MEDIAWIKI_UPLOAD_ALLOWED_DOMAINS env-var allowlist (fail-closed when unset), and private/internal IPs are blocked unconditionally. Decoded file_data is capped at 100 MiB by default — matching MediaWiki's default max upload size — and is adjustable via MEDIAWIKI_MAX_UPLOAD_DATA_BYTES. ignore_warnings=true overwrites existing files; the destructive-hint annotation is set so hosts that gate destructive operations will prompt before this runs.`,
 		ReadOnly:    false,
@@ -4,9 +4,13 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"runtime/debug"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -23,15 +27,57 @@ type HandlerRegistry struct {
 	client      *wiki.Client
 	logger      *slog.Logger
 	auditLogger ToolAuditLogger
+	version     string
+
+	// slowCallThreshold is the duration above which a tool call is logged
+	// as slow; zero disables slow-call logging.
+	slowCallThreshold time.Duration
+	// toolTimeout bounds a whole tool call for specs without their own
+	// Timeout; zero disables the bound.
+	toolTimeout time.Duration
+	// chunkResults sends large results as several content items (one per
+	// page or audit check) instead of one JSON blob.
+	chunkResults bool
+	// maxResponseBytes caps a result's marshaled size by dropping trailing
+	// list items; zero disables the cap.
+	maxResponseBytes int
+
+	// mu guards server and active, the tool set currently registered, so
+	// Reload can swap tools while other goroutines read the active set.
+	mu     sync.RWMutex
+	server *mcp.Server
+	active map[string]ToolSpec
 }
 
 // NewHandlerRegistry creates a new handler registry.
 func NewHandlerRegistry(client *wiki.Client, logger *slog.Logger) *HandlerRegistry {
-	return &HandlerRegistry{
+	h := &HandlerRegistry{
 		client:      client,
 		logger:      logger,
 		auditLogger: NullToolAuditLogger{},
+
+		slowCallThreshold: DefaultSlowCallThreshold,
+		toolTimeout:       DefaultToolTimeout,
+	}
+	if client != nil {
+		h.maxResponseBytes = client.MaxResponseBytes()
 	}
+	return h
+}
+
+// DefaultToolTimeout is the default upper bound on a single tool call,
+// across all the wiki requests it makes.
+const DefaultToolTimeout = 5 * time.Minute
+
+// DefaultSlowCallThreshold is the default duration above which a tool call
+// is logged as slow.
+const DefaultSlowCallThreshold = 5 * time.Second
+
+// WithSlowCallThreshold sets the duration above which tool calls are logged
+// at WARN level. Zero disables slow-call logging.
+func (h *HandlerRegistry) WithSlowCallThreshold(d time.Duration) *HandlerRegistry {
+	h.slowCallThreshold = d
+	return h
 }
 
 // WithAuditLogger sets the handler-level audit logger.
@@ -42,14 +88,95 @@ func (h *HandlerRegistry) WithAuditLogger(l ToolAuditLogger) *HandlerRegistry {
 	return h
 }
 
-// RegisterAll registers all tools with the MCP server.
+// WithVersion sets the server version reported by mediawiki_get_capabilities.
+func (h *HandlerRegistry) WithVersion(version string) *HandlerRegistry {
+	h.version = version
+	return h
+}
+
+// WithChunkedResults makes batch reads and health audits return one content
+// item per page or check, so a client can start on the first pages without
+// parsing one large JSON document. The structured content still carries the
+// whole result: the SDK has no way to stream a tool result, so this does not
+// lower peak memory. Off by default.
+func (h *HandlerRegistry) WithChunkedResults(enabled bool) *HandlerRegistry {
+	h.chunkResults = enabled
+	return h
+}
+
+// WithMaxResponseBytes caps the marshaled size of tool results, overriding
+// the client's Config.MaxResponseBytes. Zero disables the cap.
+func (h *HandlerRegistry) WithMaxResponseBytes(n int) *HandlerRegistry {
+	h.maxResponseBytes = n
+	return h
+}
+
+// WithToolTimeout sets the upper bound on a tool call for tools whose spec
+// sets no Timeout. Zero disables the bound.
+func (h *HandlerRegistry) WithToolTimeout(d time.Duration) *HandlerRegistry {
+	h.toolTimeout = d
+	return h
+}
+
+// RegisterAll registers all tools with the MCP server, along with the
+// middleware that resolves namespace names in tool arguments.
 func (h *HandlerRegistry) RegisterAll(server *mcp.Server) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.server = server
+	server.AddReceivingMiddleware(h.namespaceArgMiddleware)
+	h.active = make(map[string]ToolSpec, len(AllTools))
 	for _, spec := range AllTools {
 		h.registerByName(server, spec)
 	}
 	h.logger.Info("Registered all tools", "count", len(AllTools))
 }
 
+// Reload replaces the registered tool set with specs: tools missing from
+// specs are removed from the server and every spec is (re-)registered,
+// replacing any tool of the same name. Calls already in flight finish on the
+// handler they started with; the MCP server resolves the handler per call.
+// RegisterAll must have been called first.
+func (h *HandlerRegistry) Reload(specs []ToolSpec) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.server == nil {
+		return fmt.Errorf("reload before RegisterAll: no MCP server to register tools with")
+	}
+
+	keep := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		keep[spec.Name] = true
+	}
+	var removed []string
+	for name := range h.active {
+		if !keep[name] {
+			removed = append(removed, name)
+		}
+	}
+	h.server.RemoveTools(removed...)
+
+	h.active = make(map[string]ToolSpec, len(specs))
+	for _, spec := range specs {
+		h.registerByName(h.server, spec)
+	}
+	h.logger.Info("Reloaded tools", "count", len(h.active), "removed", len(removed))
+	return nil
+}
+
+// ActiveTools returns the specs of the currently registered tools, sorted
+// by name.
+func (h *HandlerRegistry) ActiveTools() []ToolSpec {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	specs := make([]ToolSpec, 0, len(h.active))
+	for _, spec := range h.active {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+	return specs
+}
+
 // methodRegistrar binds a ToolSpec.Method name to a closure that registers
 // the matching typed handler. Each closure carries its method-specific Args
 // and Result types via Go generic type inference on register[Args, Result].
@@ -94,9 +221,18 @@ var methodRegistrars = map[string]methodRegistrar{
 	"Parse": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.Parse)
 	},
+	"PreviewEdit": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.PreviewEdit)
+	},
+	"ExpandTemplates": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.ExpandTemplates)
+	},
 	"GetWikiInfo": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.GetWikiInfo)
 	},
+	"GetCapabilities": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.GetCapabilities)
+	},
 
 	// Category tools
 	"ListCategories": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
@@ -110,12 +246,21 @@ var methodRegistrars = map[string]methodRegistrar{
 	"GetRecentChanges": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.GetRecentChanges)
 	},
+	"GetRecentChangesFeed": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetRecentChangesFeed)
+	},
 	"GetRevisions": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.GetRevisions)
 	},
 	"CompareRevisions": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.CompareRevisions)
 	},
+	"GetDeletedRevisions": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetDeletedRevisions)
+	},
+	"DetectEditWars": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.DetectEditWars)
+	},
 	"GetUserContributions": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.GetUserContributions)
 	},
@@ -139,17 +284,50 @@ var methodRegistrars = map[string]methodRegistrar{
 	"FindOrphanedPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.FindOrphanedPages)
 	},
+	"FindMissingModules": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.FindMissingModules)
+	},
+	"FindDeadEndPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.FindDeadEndPages)
+	},
+	"GetMostLinkedPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetMostLinkedPages)
+	},
+	"GetWantedPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetWantedPages)
+	},
 
 	// Quality tools
 	"CheckTerminology": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.CheckTerminology)
 	},
+	"CheckSpelling": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.CheckSpelling)
+	},
 	"CheckTranslations": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.CheckTranslations)
 	},
 	"HealthAudit": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.HealthAudit)
 	},
+	"FindInlinedTemplateContent": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.FindInlinedTemplateContent)
+	},
+	"FindStaleReferences": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.FindStaleReferences)
+	},
+	"GetCitations": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetCitations)
+	},
+	"FindDuplicateCitations": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.FindDuplicateCitations)
+	},
+	"GetTemplateUsageStats": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetTemplateUsageStats)
+	},
+	"GetInfoboxTemplates": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.GetInfoboxTemplates)
+	},
 
 	// Discovery tools
 	"FindSimilarPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
@@ -184,9 +362,24 @@ var methodRegistrars = map[string]methodRegistrar{
 	"MovePage": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.MovePage)
 	},
+	"DeletePage": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.DeletePage)
+	},
+	"UndeletePage": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.UndeletePage)
+	},
+	"ProtectPage": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.ProtectPage)
+	},
 	"ManageCategories": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.ManageCategories)
 	},
+	"RecategorizePages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.RecategorizePages)
+	},
+	"AddCategoryToPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.AddCategoryToPages)
+	},
 
 	// Wiki hygiene tools
 	"GetStalePages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
@@ -197,6 +390,12 @@ var methodRegistrars = map[string]methodRegistrar{
 	"EditPage": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.EditPage)
 	},
+	"EditSection": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.EditSection)
+	},
+	"MoveSection": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.MoveSection)
+	},
 	"FindReplace": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.FindReplace)
 	},
@@ -206,16 +405,24 @@ var methodRegistrars = map[string]methodRegistrar{
 	"BulkReplace": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.BulkReplace)
 	},
+	"NullEditPages": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.NullEditPages)
+	},
 	"UploadFile": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
 		register(h, s, t, sp, h.client.UploadFile)
 	},
+	"PublishMarkdown": func(h *HandlerRegistry, s *mcp.Server, t *mcp.Tool, sp ToolSpec) {
+		register(h, s, t, sp, h.client.PublishMarkdown)
+	},
 }
 
-// registerByName looks up the registrar for spec.Method and invokes it.
+// registerByName looks up the registrar for spec.Method and invokes it,
+// recording the spec as active. Callers must hold h.mu.
 func (h *HandlerRegistry) registerByName(server *mcp.Server, spec ToolSpec) {
 	tool := h.buildTool(spec)
 	if r, ok := methodRegistrars[spec.Method]; ok {
 		r(h, server, tool, spec)
+		h.active[spec.Name] = spec
 		return
 	}
 	h.logger.Error("Unknown method, tool not registered", "method", spec.Method, "tool", spec.Name)
@@ -275,9 +482,19 @@ func register[Args, Result any](
 		metrics.RequestInFlight.WithLabelValues(spec.Name).Inc()
 		defer metrics.RequestInFlight.WithLabelValues(spec.Name).Dec()
 
+		timeout := h.timeoutFor(spec)
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
 		start := time.Now()
 		result, err := method(ctx, args)
 		duration := time.Since(start).Seconds()
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("timed out after %s: %w", timeout, err)
+		}
 
 		span.SetAttributes(attribute.Float64("mcp.tool.duration_seconds", duration))
 
@@ -285,7 +502,7 @@ func register[Args, Result any](
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			metrics.RecordRequest(spec.Name, duration, false)
-			h.auditLogger.Log(newToolCallEntry(spec, args, err, start))
+			h.logToolCall(newToolCallEntry(spec, args, err, start))
 			var zero Result
 			return nil, zero, fmt.Errorf("%s failed: %w", spec.Name, err)
 		}
@@ -293,11 +510,83 @@ func register[Args, Result any](
 		span.SetStatus(codes.Ok, "")
 		metrics.RecordRequest(spec.Name, duration, true)
 		h.logExecution(spec, args, result)
-		h.auditLogger.Log(newToolCallEntry(spec, args, nil, start))
-		return nil, result, nil
+		h.logToolCall(newToolCallEntry(spec, args, nil, start))
+
+		var trunc *truncation
+		if h.maxResponseBytes > 0 {
+			if result, trunc, err = truncateResult(result, h.maxResponseBytes); err != nil {
+				return nil, result, fmt.Errorf("%s failed: %w", spec.Name, err)
+			}
+			if trunc != nil {
+				h.logger.Warn("Tool result truncated", "tool", spec.Name, "field", trunc.Field, "omitted", trunc.Omitted, "limit_bytes", h.maxResponseBytes)
+				if trunc.OverLimit {
+					h.logger.Warn("Tool result still over the size limit after truncation", "tool", spec.Name, "field", trunc.Field, "limit_bytes", h.maxResponseBytes)
+				}
+			}
+		}
+		res, err = h.resultContent(result, trunc)
+		if err != nil {
+			return nil, result, fmt.Errorf("%s failed: %w", spec.Name, err)
+		}
+		return res, result, nil
 	})
 }
 
+// resultContent builds the content of a successful call when it differs
+// from the SDK default of one JSON text item: in chunked mode, and when the
+// result was truncated, which adds a notice item and flags _meta. It
+// returns nil to keep the default.
+func (h *HandlerRegistry) resultContent(result any, trunc *truncation) (*mcp.CallToolResult, error) {
+	var content []mcp.Content
+	if h.chunkResults {
+		var err error
+		if content, err = chunkedContent(result); err != nil {
+			return nil, err
+		}
+	}
+	if trunc == nil {
+		if content == nil {
+			return nil, nil
+		}
+		return &mcp.CallToolResult{Content: content}, nil
+	}
+
+	if content == nil {
+		data, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+		content = []mcp.Content{&mcp.TextContent{Text: string(data)}}
+	}
+	return &mcp.CallToolResult{
+		Meta:    trunc.meta(),
+		Content: append(content, trunc.notice(h.maxResponseBytes)),
+	}, nil
+}
+
+// timeoutFor returns the maximum duration of a call to spec's tool.
+func (h *HandlerRegistry) timeoutFor(spec ToolSpec) time.Duration {
+	if spec.Timeout > 0 {
+		return spec.Timeout
+	}
+	return h.toolTimeout
+}
+
+// logToolCall writes a tool call to the audit log and, independently of
+// audit logging, emits a WARN line when the call exceeded the slow-call
+// threshold.
+func (h *HandlerRegistry) logToolCall(entry ToolCallEntry) {
+	h.auditLogger.Log(entry)
+	duration := time.Duration(entry.DurationMs) * time.Millisecond
+	if h.slowCallThreshold > 0 && duration > h.slowCallThreshold {
+		h.logger.Warn("Slow tool call",
+			"tool", entry.Tool,
+			"duration_ms", entry.DurationMs,
+			"threshold_ms", h.slowCallThreshold.Milliseconds(),
+			"success", entry.Success)
+	}
+}
+
 // recoverPanic recovers from panics in tool handlers and converts them into a
 // structured error with a correlation ID. The panic value and stack are logged
 // server-side; only the correlation ID reaches the MCP caller.
@@ -355,11 +644,19 @@ func appendArgAttrs(attrs []any, args any) []any {
 	case wiki.SearchInPageArgs:
 		return append(attrs, "title", a.Title, "query", a.Query)
 	case wiki.EditPageArgs:
+		return append(attrs, "title", a.Title, "content_len", len(a.Content)+len(a.AppendText)+len(a.PrependText))
+	case wiki.EditSectionArgs:
 		return append(attrs, "title", a.Title, "content_len", len(a.Content))
+	case wiki.MoveSectionArgs:
+		return append(attrs, "from", a.FromTitle, "to", a.ToTitle)
 	case wiki.FindReplaceArgs:
 		return append(attrs, "title", a.Title, "preview", a.PreviewEnabled())
 	case wiki.BulkReplaceArgs:
 		return append(attrs, "pages_count", len(a.Pages), "preview", a.PreviewEnabled())
+	case wiki.NullEditPagesArgs:
+		return append(attrs, "pages_count", len(a.Pages))
+	case wiki.PublishMarkdownArgs:
+		return append(attrs, "pages_count", len(a.Pages), "preview", a.Preview)
 	case wiki.GetPagesBatchArgs:
 		return append(attrs, "titles_count", len(a.Titles))
 	case wiki.SearchAndReadArgs:
@@ -368,10 +665,20 @@ func appendArgAttrs(attrs []any, args any) []any {
 		return append(attrs, "title", a.Title)
 	case wiki.MovePageArgs:
 		return append(attrs, "from", a.From, "to", a.To)
+	case wiki.DeletePageArgs:
+		return append(attrs, "title", a.Title)
+	case wiki.UndeletePageArgs:
+		return append(attrs, "title", a.Title, "timestamps", len(a.Timestamps))
+	case wiki.ProtectPageArgs:
+		return append(attrs, "title", a.Title, "protections", len(a.Protections))
 	case wiki.ManageCategoriesArgs:
 		return append(attrs, "title", a.Title, "add", len(a.Add), "remove", len(a.Remove))
 	case wiki.GetStalePagesArgs:
 		return append(attrs, "days", a.Days, "category", a.Category)
+	case wiki.RecategorizePagesArgs:
+		return append(attrs, "from", a.OldCategory, "to", a.NewCategory, "preview", a.PreviewEnabled())
+	case wiki.AddCategoryToPagesArgs:
+		return append(attrs, "category", a.Category, "pages", len(a.Pages), "preview", a.PreviewEnabled())
 	}
 	return attrs
 }
@@ -389,6 +696,10 @@ func appendResultAttrs(attrs []any, result any) []any {
 		return append(attrs, "matches", r.MatchCount, "replaced", r.ReplaceCount)
 	case wiki.BulkReplaceResult:
 		return append(attrs, "pages_modified", r.PagesModified, "total_changes", r.TotalChanges)
+	case wiki.NullEditPagesResult:
+		return append(attrs, "succeeded", r.SuccessCount, "failed", r.FailureCount)
+	case wiki.PublishMarkdownResult:
+		return append(attrs, "succeeded", r.SuccessCount, "failed", r.FailureCount)
 	case wiki.GetPagesBatchResult:
 		return append(attrs, "found", r.FoundCount, "missing", r.MissingCount)
 	case wiki.SearchAndReadResult:
@@ -1,11 +1,16 @@
 package tools
 
 import (
+	"bytes"
+	"context"
 	"log/slog"
 	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
 )
 
@@ -222,19 +227,19 @@ func TestToolSpecMethods(t *testing.T) {
 	knownMethods := map[string]bool{
 		"Search": true, "SearchInPage": true, "SearchInFile": true, "ResolveTitle": true,
 		"GetPage": true, "ListPages": true, "GetPageInfo": true, "GetSections": true,
-		"GetRelated": true, "GetImages": true, "Parse": true, "GetWikiInfo": true,
+		"GetRelated": true, "GetImages": true, "Parse": true, "PreviewEdit": true, "ExpandTemplates": true, "GetWikiInfo": true, "GetCapabilities": true,
 		"ListCategories": true, "GetCategoryMembers": true,
-		"GetRecentChanges": true, "GetRevisions": true, "CompareRevisions": true, "GetUserContributions": true,
+		"GetRecentChanges": true, "GetRecentChangesFeed": true, "GetRevisions": true, "CompareRevisions": true, "GetDeletedRevisions": true, "DetectEditWars": true, "GetUserContributions": true,
 		"GetExternalLinks": true, "GetExternalLinksBatch": true, "CheckLinks": true, "GetBacklinks": true,
-		"FindBrokenInternalLinks": true, "FindOrphanedPages": true,
-		"CheckTerminology": true, "CheckTranslations": true, "HealthAudit": true,
+		"FindBrokenInternalLinks": true, "FindMissingModules": true, "FindOrphanedPages": true, "FindDeadEndPages": true, "GetMostLinkedPages": true, "GetWantedPages": true,
+		"CheckTerminology": true, "CheckSpelling": true, "CheckTranslations": true, "HealthAudit": true, "FindInlinedTemplateContent": true, "FindStaleReferences": true, "GetCitations": true, "FindDuplicateCitations": true, "GetTemplateUsageStats": true, "GetInfoboxTemplates": true,
 		"FindSimilarPages": true, "CompareTopic": true,
 		"ListUsers":     true,
 		"GetPagesBatch": true, "GetPagesInfoBatch": true,
 		"SearchAndRead": true, "GetPageSummary": true,
-		"MovePage": true, "ManageCategories": true,
+		"MovePage": true, "DeletePage": true, "UndeletePage": true, "ProtectPage": true, "ManageCategories": true, "RecategorizePages": true, "AddCategoryToPages": true,
 		"GetStalePages": true,
-		"EditPage":      true, "FindReplace": true, "ApplyFormatting": true, "BulkReplace": true, "UploadFile": true,
+		"EditPage":      true, "EditSection": true, "MoveSection": true, "FindReplace": true, "ApplyFormatting": true, "BulkReplace": true, "NullEditPages": true, "UploadFile": true, "PublishMarkdown": true,
 	}
 
 	for _, spec := range AllTools {
@@ -243,3 +248,222 @@ func TestToolSpecMethods(t *testing.T) {
 		}
 	}
 }
+
+func TestReloadConcurrentWithCalls(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	// Unconfigured client: every tool call fails fast without network access.
+	client := wiki.NewClient(&wiki.Config{}, logger)
+	defer client.Close()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil)
+	registry := NewHandlerRegistry(client, logger)
+	if err := registry.Reload(AllTools); err == nil {
+		t.Error("expected Reload before RegisterAll to fail")
+	}
+	registry.RegisterAll(server)
+
+	ctx := context.Background()
+	session := connectTestSession(t, server)
+
+	var subset []ToolSpec
+	for _, spec := range AllTools {
+		if spec.ReadOnly {
+			subset = append(subset, spec)
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			specs := AllTools
+			if i%2 == 0 {
+				specs = subset
+			}
+			if err := registry.Reload(specs); err != nil {
+				t.Errorf("Reload: %v", err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		res, err := session.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "mediawiki_get_page",
+			Arguments: map[string]any{"title": "Main Page"},
+		})
+		if err != nil {
+			t.Fatalf("CallTool during reload: %v", err)
+		}
+		if !res.IsError {
+			t.Error("expected a tool error from the unconfigured client")
+		}
+	}
+	wg.Wait()
+
+	if err := registry.Reload(subset); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	if got := len(registry.ActiveTools()); got != len(subset) {
+		t.Errorf("ActiveTools = %d, want %d", got, len(subset))
+	}
+	listed, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListTools: %v", err)
+	}
+	for _, tool := range listed.Tools {
+		if tool.Name == "mediawiki_edit_page" {
+			t.Error("write tool still listed after reloading the read-only subset")
+		}
+	}
+}
+
+// connectTestSession connects an MCP client to server over in-memory
+// transports, closing the session when the test ends.
+func connectTestSession(t *testing.T, server *mcp.Server) *mcp.ClientSession {
+	t.Helper()
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	if _, err := server.Connect(ctx, serverTransport, nil); err != nil {
+		t.Fatalf("server connect: %v", err)
+	}
+	session, err := mcp.NewClient(&mcp.Implementation{Name: "client", Version: "0"}, nil).Connect(ctx, clientTransport, nil)
+	if err != nil {
+		t.Fatalf("client connect: %v", err)
+	}
+	t.Cleanup(func() { _ = session.Close() })
+	return session
+}
+
+type slowArgs struct {
+	Delay string `json:"delay,omitempty"`
+}
+
+type slowResult struct {
+	Done bool `json:"done"`
+}
+
+func TestSlowCallLogging(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	registry := NewHandlerRegistry(nil, logger).WithSlowCallThreshold(20 * time.Millisecond)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil)
+	spec := ToolSpec{Name: "test_sleep", Method: "Sleep", ReadOnly: true}
+	register(registry, server, registry.buildTool(spec), spec, func(ctx context.Context, args slowArgs) (slowResult, error) {
+		d, err := time.ParseDuration(args.Delay)
+		if err != nil {
+			return slowResult{}, err
+		}
+		time.Sleep(d)
+		return slowResult{Done: true}, nil
+	})
+	session := connectTestSession(t, server)
+
+	call := func(delay string) {
+		t.Helper()
+		if _, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "test_sleep",
+			Arguments: map[string]any{"delay": delay},
+		}); err != nil {
+			t.Fatalf("CallTool: %v", err)
+		}
+	}
+
+	call("0s")
+	if logs.Len() != 0 {
+		t.Errorf("fast call was logged as slow: %s", logs.String())
+	}
+
+	call("40ms")
+	out := logs.String()
+	if !strings.Contains(out, "Slow tool call") || !strings.Contains(out, "tool=test_sleep") || !strings.Contains(out, "level=WARN") {
+		t.Errorf("expected a WARN slow-call line for test_sleep, got %q", out)
+	}
+}
+
+func TestToolTimeoutCancelsSlowHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	registry := NewHandlerRegistry(nil, logger).WithToolTimeout(time.Hour)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil)
+	// The spec's own Timeout takes precedence over the registry default.
+	spec := ToolSpec{Name: "test_hang", Method: "Hang", ReadOnly: true, Timeout: 50 * time.Millisecond}
+	cancelled := make(chan struct{})
+	register(registry, server, registry.buildTool(spec), spec, func(ctx context.Context, _ slowArgs) (slowResult, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return slowResult{}, ctx.Err()
+	})
+	session := connectTestSession(t, server)
+
+	start := time.Now()
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "test_hang",
+		Arguments: map[string]any{},
+	})
+	if err != nil {
+		t.Fatalf("CallTool: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("call took %s, want it bounded by the 50ms tool timeout", elapsed)
+	}
+	select {
+	case <-cancelled:
+	default:
+		t.Error("handler context was not cancelled")
+	}
+	if !res.IsError {
+		t.Fatal("expected a tool error after the timeout")
+	}
+	text := res.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "timed out after 50ms") {
+		t.Errorf("error = %q, want a clear timeout message", text)
+	}
+}
+
+func TestChunkedResults(t *testing.T) {
+	batch := wiki.GetPagesBatchResult{
+		Pages: []wiki.PageContentResult{
+			{Title: "Alpha", Exists: true, Content: "a"},
+			{Title: "Beta", Exists: true, Content: "b"},
+		},
+		TotalCount: 2,
+		FoundCount: 2,
+	}
+	call := func(chunked bool) *mcp.CallToolResult {
+		t.Helper()
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+		registry := NewHandlerRegistry(nil, logger).WithChunkedResults(chunked)
+		server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil)
+		spec := ToolSpec{Name: "test_batch", Method: "Batch", ReadOnly: true}
+		register(registry, server, registry.buildTool(spec), spec, func(context.Context, slowArgs) (wiki.GetPagesBatchResult, error) {
+			return batch, nil
+		})
+		res, err := connectTestSession(t, server).CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "test_batch",
+			Arguments: map[string]any{},
+		})
+		if err != nil || res.IsError {
+			t.Fatalf("CallTool: %v, %+v", err, res)
+		}
+		return res
+	}
+
+	if res := call(false); len(res.Content) != 1 {
+		t.Errorf("aggregated mode sent %d content items, want 1", len(res.Content))
+	}
+
+	res := call(true)
+	if len(res.Content) != 3 {
+		t.Fatalf("chunked mode sent %d content items, want a header and one per page", len(res.Content))
+	}
+	for i, want := range []string{`"total_count":2`, `"title":"Alpha"`, `"title":"Beta"`} {
+		if text := res.Content[i].(*mcp.TextContent).Text; !strings.Contains(text, want) {
+			t.Errorf("content[%d] = %s, want %s", i, text, want)
+		}
+	}
+	if res.StructuredContent == nil {
+		t.Error("chunked mode dropped the structured content")
+	}
+}
@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// namespaceArgMiddleware lets callers pass a tool's "namespace" argument by
+// name ("Template") as well as by ID (10). A string value is resolved with
+// wiki.Client.ResolveNamespace and replaced by its ID before the arguments
+// reach the tool, whose schema declares an integer.
+func (h *HandlerRegistry) namespaceArgMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		call, ok := req.(*mcp.CallToolRequest)
+		if method != "tools/call" || !ok || call.Params == nil {
+			return next(ctx, method, req)
+		}
+		args, err := h.resolveNamespaceArg(ctx, call.Params.Arguments)
+		if err != nil {
+			return &mcp.CallToolResult{
+				IsError: true,
+				Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("%s failed: %v", call.Params.Name, err)}},
+			}, nil
+		}
+		call.Params.Arguments = args
+		return next(ctx, method, req)
+	}
+}
+
+// resolveNamespaceArg returns raw with a string "namespace" value replaced by
+// the namespace ID. Arguments without one, or that are not a JSON object,
+// are returned unchanged for the tool's own validation to handle.
+func (h *HandlerRegistry) resolveNamespaceArg(ctx context.Context, raw json.RawMessage) (json.RawMessage, error) {
+	var args map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return raw, nil
+	}
+	var name string
+	if err := json.Unmarshal(args["namespace"], &name); err != nil {
+		return raw, nil
+	}
+	id, err := h.client.ResolveNamespace(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	args["namespace"] = json.RawMessage(strconv.Itoa(id))
+	return json.Marshal(args)
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
+)
+
+func TestNamespaceArgMiddleware(t *testing.T) {
+	var mu sync.Mutex
+	var apnamespace []string
+	wikiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("meta") == "siteinfo":
+			_, _ = w.Write([]byte(`{"query":{"namespaces":{"0":{"id":0,"*":""},"10":{"id":10,"canonical":"Template","*":"Template"}},"namespacealiases":[]}}`))
+		case r.FormValue("list") == "allpages":
+			mu.Lock()
+			apnamespace = append(apnamespace, r.FormValue("apnamespace"))
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"query":{"allpages":[]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"query":{}}`))
+		}
+	}))
+	defer wikiServer.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	client := wiki.NewClient(&wiki.Config{BaseURL: wikiServer.URL, Timeout: 5 * time.Second, MaxRetries: 1}, logger)
+	defer client.Close()
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0"}, nil)
+	NewHandlerRegistry(client, logger).RegisterAll(server)
+	session := connectTestSession(t, server)
+
+	for _, namespace := range []interface{}{"Template", 10, "template:"} {
+		res, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+			Name:      "mediawiki_list_pages",
+			Arguments: map[string]interface{}{"namespace": namespace, "prefix": "Info"},
+		})
+		if err != nil || res.IsError {
+			t.Fatalf("namespace %v: err=%v result=%+v", namespace, err, res)
+		}
+	}
+	if len(apnamespace) != 3 {
+		t.Fatalf("list requests = %d, want 3", len(apnamespace))
+	}
+	for i, got := range apnamespace {
+		if got != "10" {
+			t.Errorf("call %d sent apnamespace=%q, want 10", i, got)
+		}
+	}
+
+	res, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "mediawiki_list_pages",
+		Arguments: map[string]interface{}{"namespace": "Nonexistent"},
+	})
+	if err != nil {
+		t.Fatalf("CallTool: %v", err)
+	}
+	if !res.IsError || len(res.Content) == 0 || !strings.Contains(res.Content[0].(*mcp.TextContent).Text, "unknown namespace") {
+		t.Errorf("result = %+v, want an unknown namespace error", res)
+	}
+}
@@ -3,6 +3,8 @@
 // using type-safe handlers to register them.
 package tools
 
+import "time"
+
 // ToolSpec defines a tool's metadata for declarative registration.
 // Each spec maps to a wiki.Client method with matching Args/Result types.
 type ToolSpec struct {
@@ -32,6 +34,10 @@ type ToolSpec struct {
 
 	// OpenWorld indicates the tool accesses external resources
 	OpenWorld bool
+
+	// Timeout bounds a single call of the tool; zero uses the registry's
+	// default tool timeout
+	Timeout time.Duration
 }
 
 // ptr is a helper to create a pointer to a value.
@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// truncation describes the items dropped from a result to fit the response
+// size cap.
+type truncation struct {
+	Field     string // JSON name of the shortened list
+	Total     int    // items in the list before truncation
+	Omitted   int    // trailing items dropped
+	OverLimit bool   // the result exceeds the cap even with the list emptied
+}
+
+// truncateResult shortens result until its JSON encoding fits in limit
+// bytes by dropping trailing items from the top-level list that takes up
+// the most bytes. The returned truncation is nil when nothing was dropped:
+// the result already fits, or it has no list to shorten (it is then
+// returned whole, since cutting fields would break the output schema).
+// When the other fields alone exceed the limit, the list is emptied and
+// the truncation is marked OverLimit.
+func truncateResult[Result any](result Result, limit int) (Result, *truncation, error) {
+	data, err := json.Marshal(result)
+	if err != nil || len(data) <= limit {
+		return result, nil, err
+	}
+
+	v := reflect.ValueOf(&result).Elem()
+	if v.Kind() != reflect.Struct {
+		return result, nil, nil
+	}
+	field, name := largestListField(v)
+	if !field.IsValid() {
+		return result, nil, nil
+	}
+
+	full := field.Slice(0, field.Len())
+	fits := func(n int) bool {
+		field.Set(full.Slice(0, n))
+		data, err := json.Marshal(result)
+		return err == nil && len(data) <= limit
+	}
+	// Largest prefix that fits; zero items if even that is too big.
+	keep := sort.Search(full.Len()+1, func(n int) bool { return !fits(n) }) - 1
+	field.Set(full.Slice(0, max(keep, 0)))
+
+	return result, &truncation{
+		Field:     name,
+		Total:     full.Len(),
+		Omitted:   full.Len() - max(keep, 0),
+		OverLimit: keep < 0,
+	}, nil
+}
+
+// largestListField returns the exported top-level slice field of v whose
+// JSON encoding is largest, with its JSON name. Item counts are a poor
+// proxy: a few full page texts outweigh hundreds of titles.
+func largestListField(v reflect.Value) (reflect.Value, string) {
+	var best reflect.Value
+	bestName, bestSize := "", 0
+	for i := 0; i < v.NumField(); i++ {
+		sf := v.Type().Field(i)
+		f := v.Field(i)
+		if !sf.IsExported() || f.Kind() != reflect.Slice || f.Type().Elem().Kind() == reflect.Uint8 {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" || f.Len() == 0 {
+			continue
+		}
+		data, err := json.Marshal(f.Interface())
+		if err != nil || len(data) <= bestSize {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		best, bestName, bestSize = f, name, len(data)
+	}
+	return best, bestName
+}
+
+// notice returns the content item telling the caller what was left out.
+func (t *truncation) notice(limit int) mcp.Content {
+	if t.OverLimit {
+		return &mcp.TextContent{Text: fmt.Sprintf(
+			"Response exceeds %d bytes even with all %d %q items omitted. Narrow the request (fewer pages, a smaller section) to get a complete result.",
+			limit, t.Total, t.Field)}
+	}
+	return &mcp.TextContent{Text: fmt.Sprintf(
+		"Response truncated to fit %d bytes: the last %d of %d %q items were omitted. Narrow the request (a lower limit, fewer pages) to see them.",
+		limit, t.Omitted, t.Total, t.Field)}
+}
+
+// meta returns the _meta fields that flag a truncated result.
+func (t *truncation) meta() mcp.Meta {
+	m := mcp.Meta{"truncated": true, "truncated_field": t.Field, "omitted": t.Omitted}
+	if t.OverLimit {
+		m["over_limit"] = true
+	}
+	return m
+}
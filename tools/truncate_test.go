@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/olgasafonova/mediawiki-mcp-server/wiki"
+)
+
+func TestTruncateResult(t *testing.T) {
+	result := wiki.ListPagesResult{Pages: make([]wiki.PageSummary, 50), ReturnedCount: 50}
+	for i := range result.Pages {
+		result.Pages[i] = wiki.PageSummary{PageID: i, Title: strings.Repeat("x", 20)}
+	}
+	full, _ := json.Marshal(result)
+
+	got, trunc, err := truncateResult(result, len(full))
+	if err != nil || trunc != nil || len(got.Pages) != 50 {
+		t.Errorf("under the limit: %d pages, truncation %+v, err %v; want the result untouched", len(got.Pages), trunc, err)
+	}
+
+	limit := len(full) / 2
+	got, trunc, err = truncateResult(result, limit)
+	if err != nil || trunc == nil {
+		t.Fatalf("over the limit: truncation %+v, err %v", trunc, err)
+	}
+	data, _ := json.Marshal(got)
+	if len(data) > limit {
+		t.Errorf("truncated result is %d bytes, over the %d-byte limit", len(data), limit)
+	}
+	if trunc.Field != "pages" || trunc.Total != 50 || trunc.Omitted != 50-len(got.Pages) || len(got.Pages) == 0 {
+		t.Errorf("truncation = %+v with %d pages kept", trunc, len(got.Pages))
+	}
+	if got.Pages[0].PageID != 0 || len(result.Pages) != 50 {
+		t.Error("truncation must keep the leading items and leave the caller's slice alone")
+	}
+}
+
+func TestTruncateResult_LargestListByBytes(t *testing.T) {
+	type result struct {
+		Titles   []string `json:"titles"`
+		Contents []string `json:"contents"`
+		Note     string   `json:"note"`
+	}
+	r := result{Titles: make([]string, 100), Contents: make([]string, 5)}
+	for i := range r.Titles {
+		r.Titles[i] = "T"
+	}
+	for i := range r.Contents {
+		r.Contents[i] = strings.Repeat("c", 200)
+	}
+	full, _ := json.Marshal(r)
+
+	got, trunc, err := truncateResult(r, len(full)-100)
+	if err != nil || trunc == nil {
+		t.Fatalf("truncation %+v, err %v", trunc, err)
+	}
+	if trunc.Field != "contents" || len(got.Titles) != 100 || trunc.OverLimit {
+		t.Errorf("truncation = %+v with %d titles kept, want the byte-heavy contents list cut", trunc, len(got.Titles))
+	}
+
+	r.Note = strings.Repeat("n", 2000)
+	got, trunc, err = truncateResult(r, 1000)
+	if err != nil || trunc == nil {
+		t.Fatalf("over limit: truncation %+v, err %v", trunc, err)
+	}
+	if !trunc.OverLimit || trunc.Field != "contents" || len(got.Contents) != 0 {
+		t.Errorf("truncation = %+v with %d contents kept, want an emptied list marked over the limit", trunc, len(got.Contents))
+	}
+	if meta := trunc.meta(); meta["over_limit"] != true {
+		t.Errorf("_meta = %v, want over_limit=true", meta)
+	}
+}
+
+func TestMaxResponseBytes(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	registry := NewHandlerRegistry(nil, logger).WithMaxResponseBytes(200)
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "0"}, nil)
+	spec := ToolSpec{Name: "test_list", Method: "List", ReadOnly: true}
+	register(registry, server, registry.buildTool(spec), spec, func(context.Context, slowArgs) (wiki.ListPagesResult, error) {
+		pages := make([]wiki.PageSummary, 20)
+		for i := range pages {
+			pages[i] = wiki.PageSummary{PageID: i, Title: "Page title"}
+		}
+		return wiki.ListPagesResult{Pages: pages, ReturnedCount: len(pages)}, nil
+	})
+
+	res, err := connectTestSession(t, server).CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "test_list",
+		Arguments: map[string]any{},
+	})
+	if err != nil || res.IsError {
+		t.Fatalf("CallTool: %v, %+v", err, res)
+	}
+	if len(res.Content) != 2 {
+		t.Fatalf("got %d content items, want the result and a truncation notice", len(res.Content))
+	}
+	if text := res.Content[0].(*mcp.TextContent).Text; len(text) > 200 {
+		t.Errorf("result text is %d bytes, want at most 200", len(text))
+	}
+	if notice := res.Content[1].(*mcp.TextContent).Text; !strings.Contains(notice, `"pages" items were omitted`) {
+		t.Errorf("notice = %q", notice)
+	}
+	if res.Meta["truncated"] != true {
+		t.Errorf("_meta = %v, want truncated=true", res.Meta)
+	}
+}
@@ -14,6 +14,9 @@ import (
 // boolPtr returns a pointer to b, for setting tri-state args like Preview.
 func boolPtr(b bool) *bool { return &b }
 
+// intPtr returns a pointer to n, for setting optional int args like Section.
+func intPtr(n int) *int { return &n }
+
 // createMockClient creates a client that talks to a mock server
 func createMockClient(t *testing.T, server *httptest.Server) *Client {
 	t.Helper()
@@ -22,6 +22,20 @@ const (
 	AuditOpCreate AuditOperation = "create"
 	// AuditOpUpload represents a file upload operation
 	AuditOpUpload AuditOperation = "upload"
+	// AuditOpMove represents a page move operation
+	AuditOpMove AuditOperation = "move"
+	// AuditOpDelete represents a page deletion
+	AuditOpDelete AuditOperation = "delete"
+	// AuditOpUndelete represents the restore of a deleted page
+	AuditOpUndelete AuditOperation = "undelete"
+	// AuditOpProtect represents a change to a page's protection
+	AuditOpProtect AuditOperation = "protect"
+	// AuditOpNullEdit represents a re-save of unchanged content that only
+	// refreshes a page's link tables
+	AuditOpNullEdit AuditOperation = "null_edit"
+	// AuditOpDryRun represents a write that was skipped because the client
+	// runs in dry-run mode
+	AuditOpDryRun AuditOperation = "dry_run"
 )
 
 // AuditEntry represents a single auditable write operation
@@ -29,9 +43,12 @@ type AuditEntry struct {
 	// Timestamp is when the operation occurred (RFC3339 format)
 	Timestamp string `json:"timestamp"`
 
-	// Operation is the type of write operation (edit, create, upload)
+	// Operation is the type of write operation (edit, create, upload, move, delete, undelete, protect, null_edit, dry_run)
 	Operation AuditOperation `json:"operation"`
 
+	// DryRunOf is the operation that would have run, set only for dry_run entries
+	DryRunOf AuditOperation `json:"dry_run_of,omitempty"`
+
 	// Title is the page or file title that was modified
 	Title string `json:"title"`
 
@@ -10,6 +10,10 @@ import (
 // MaxBatchSize is the maximum number of pages that can be fetched in a single batch
 const MaxBatchSize = 50
 
+// MaxPageInfoBatchSize caps GetPagesInfoBatch, which splits larger title
+// lists into MaxBatchSize chunks (one API request each).
+const MaxPageInfoBatchSize = 500
+
 type pageBuildStatus int
 
 const (
@@ -191,11 +195,16 @@ func applyNormalizedMappings(query map[string]interface{}, foundTitles map[strin
 	}
 }
 
-// GetPagesInfoBatch retrieves metadata for multiple pages in a single API call.
+// GetPagesInfoBatch retrieves metadata for multiple pages, fetching up to
+// MaxBatchSize titles per API call. Missing pages and titles in a chunk whose
+// request failed are reported per title in Errors rather than failing the
+// whole batch.
 func (c *Client) GetPagesInfoBatch(ctx context.Context, args GetPagesInfoBatchArgs) (GetPagesInfoBatchResult, error) {
-	titles, err := capBatchTitles(args.Titles)
-	if err != nil {
-		return GetPagesInfoBatchResult{}, err
+	if len(args.Titles) == 0 {
+		return GetPagesInfoBatchResult{}, fmt.Errorf("at least one title is required")
+	}
+	if len(args.Titles) > MaxPageInfoBatchSize {
+		return GetPagesInfoBatchResult{}, NewBatchTooLargeError(len(args.Titles), MaxPageInfoBatchSize)
 	}
 
 	if err := c.EnsureLoggedIn(ctx); err != nil {
@@ -203,29 +212,43 @@ func (c *Client) GetPagesInfoBatch(ctx context.Context, args GetPagesInfoBatchAr
 	}
 
 	result := GetPagesInfoBatchResult{
-		Pages:      make([]PageInfo, 0, len(titles)),
-		TotalCount: len(titles),
+		Pages:      make([]PageInfo, 0, len(args.Titles)),
+		TotalCount: len(args.Titles),
+	}
+	titles := normalizeTitles(args.Titles)
+	for start := 0; start < len(titles); start += MaxBatchSize {
+		chunk := titles[start:min(start+MaxBatchSize, len(titles))]
+		if err := c.fetchPageInfoChunk(ctx, chunk, &result); err != nil {
+			for _, title := range chunk {
+				result.addError(title, err.Error())
+			}
+		}
 	}
+	return result, nil
+}
 
+// fetchPageInfoChunk requests metadata for at most MaxBatchSize titles and
+// adds each page to result.
+func (c *Client) fetchPageInfoChunk(ctx context.Context, titles []string, result *GetPagesInfoBatchResult) error {
 	params := url.Values{}
 	params.Set("action", "query")
-	params.Set("titles", strings.Join(normalizeTitles(titles), "|"))
+	params.Set("titles", strings.Join(titles, "|"))
 	params.Set("prop", "info|categories")
 	params.Set("inprop", "protection|url")
 	params.Set("cllimit", "50")
 
 	resp, err := c.apiRequest(ctx, params)
 	if err != nil {
-		return GetPagesInfoBatchResult{}, err
+		return err
 	}
 
 	_, pages, err := extractQueryPages(resp, "unexpected response format")
 	if err != nil {
-		return GetPagesInfoBatchResult{}, err
+		return err
 	}
 
-	collectPageInfos(pages, &result)
-	return result, nil
+	collectPageInfos(pages, result)
+	return nil
 }
 
 // collectPageInfos builds a PageInfo for each page and updates the batch
@@ -241,11 +264,20 @@ func collectPageInfos(pages map[string]interface{}, result *GetPagesInfoBatchRes
 			result.ExistsCount++
 		} else {
 			result.MissingCount++
+			result.addError(info.Title, "page does not exist")
 		}
 		result.Pages = append(result.Pages, info)
 	}
 }
 
+// addError records a per-title error.
+func (r *GetPagesInfoBatchResult) addError(title, msg string) {
+	if r.Errors == nil {
+		r.Errors = make(map[string]string)
+	}
+	r.Errors[title] = msg
+}
+
 // buildPageInfo converts one MediaWiki page metadata object into a PageInfo and
 // reports whether the page exists.
 func buildPageInfo(page map[string]interface{}) (PageInfo, bool) {
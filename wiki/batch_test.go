@@ -292,3 +292,60 @@ func TestGetPagesInfoBatch_Success(t *testing.T) {
 		}
 	}
 }
+
+func TestGetPagesInfoBatch_Chunks(t *testing.T) {
+	var chunkSizes []int
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		titles := strings.Split(r.FormValue("titles"), "|")
+		chunkSizes = append(chunkSizes, len(titles))
+		pages := map[string]interface{}{}
+		for i, title := range titles {
+			if title == "Page 51" {
+				pages["-1"] = map[string]interface{}{"title": title, "missing": true}
+				continue
+			}
+			pages[fmt.Sprintf("%d", i+1)] = map[string]interface{}{
+				"pageid": float64(i + 1),
+				"title":  title,
+				"length": float64(100),
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{"pages": pages},
+		})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	titles := make([]string, 51)
+	for i := range titles {
+		titles[i] = fmt.Sprintf("Page %d", i+1)
+	}
+	result, err := client.GetPagesInfoBatch(context.Background(), GetPagesInfoBatchArgs{Titles: titles})
+	if err != nil {
+		t.Fatalf("GetPagesInfoBatch: %v", err)
+	}
+
+	if len(chunkSizes) != 2 || chunkSizes[0] != 50 || chunkSizes[1] != 1 {
+		t.Errorf("chunk sizes = %v, want [50 1]", chunkSizes)
+	}
+	if result.TotalCount != 51 || result.ExistsCount != 50 || result.MissingCount != 1 {
+		t.Errorf("counts = total %d, exists %d, missing %d; want 51, 50, 1",
+			result.TotalCount, result.ExistsCount, result.MissingCount)
+	}
+	if len(result.Errors) != 1 || result.Errors["Page 51"] == "" {
+		t.Errorf("Errors = %v, want only Page 51", result.Errors)
+	}
+}
+
+func TestGetPagesInfoBatch_TooLarge(t *testing.T) {
+	client := &Client{}
+	_, err := client.GetPagesInfoBatch(context.Background(), GetPagesInfoBatchArgs{
+		Titles: make([]string, MaxPageInfoBatchSize+1),
+	})
+	if err == nil {
+		t.Error("expected an error above MaxPageInfoBatchSize")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -47,6 +48,9 @@ type Client struct {
 	loggedIn    bool
 	csrfToken   string
 	tokenExpiry time.Time
+	// tokenMu serializes CSRF token fetches so concurrent writes share one
+	// fetch instead of each requesting a token.
+	tokenMu sync.Mutex
 
 	// Rate limiting - semaphore to control concurrent requests
 	semaphore chan struct{}
@@ -114,6 +118,7 @@ func NewClient(config *Config, logger *slog.Logger) *Client {
 		"page_content": 5 * time.Minute,  // Page content
 		"categories":   10 * time.Minute, // Category lists
 		"search":       1 * time.Minute,  // Search results
+		"conditional":  30 * time.Minute, // ETag/Last-Modified validators and bodies
 	}
 
 	client := &Client{
@@ -160,6 +165,17 @@ func (c *Client) CircuitBreakerStatus() CircuitBreakerStats {
 	return c.circuitBreaker.Stats()
 }
 
+// DryRun reports whether write operations are simulated rather than saved
+func (c *Client) DryRun() bool {
+	return c.config.DryRun
+}
+
+// MaxResponseBytes returns the configured cap on a tool result's marshaled
+// size, or 0 when results are not capped
+func (c *Client) MaxResponseBytes() int {
+	return c.config.MaxResponseBytes
+}
+
 // DedupStats returns request deduplication statistics
 func (c *Client) DedupStats() int {
 	return c.dedup.Stats()
@@ -302,10 +318,15 @@ const maxResponseBytes = 16 << 20 // 16 MiB
 
 // readBoundedBody reads the response body under a hard size cap, closing the
 // body and reporting an error when the cap is exceeded (read limit+1 detects
-// overflow).
+// overflow). Compressed bodies are decoded first, so the cap bounds the
+// decoded size.
 func readBoundedBody(resp *http.Response) ([]byte, error) {
-	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
-	_ = resp.Body.Close() // Error ignored intentionally; body already read
+	defer func() { _ = resp.Body.Close() }() // Error ignored intentionally; body already read
+	reader, err := decodeResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	body, err := io.ReadAll(io.LimitReader(reader, maxResponseBytes+1))
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
@@ -345,6 +366,7 @@ func (c *Client) apiRequest(ctx context.Context, params url.Values) (map[string]
 	}
 
 	params.Set("format", "json")
+	conditionalKey := conditionalCacheKey(params)
 
 	var lastErr error
 	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
@@ -369,6 +391,7 @@ func (c *Client) apiRequest(ctx context.Context, params url.Values) (map[string]
 		req.Header.Set("User-Agent", c.config.UserAgent)
 		// Note: Don't set Accept-Encoding manually - Go's http.Transport handles
 		// compression automatically when DisableCompression is false
+		conditional := c.applyConditionalHeaders(req, conditionalKey)
 
 		c.logger.Debug("API request",
 			"action", action,
@@ -390,9 +413,10 @@ func (c *Client) apiRequest(ctx context.Context, params url.Values) (map[string]
 			lastErr = err
 			continue
 		}
+		body, status := c.resolveConditionalResponse(conditionalKey, conditional, resp, body)
 
 		// Handle different status codes appropriately
-		if resp.StatusCode != http.StatusOK {
+		if status != http.StatusOK {
 			c.logger.Debug("API non-OK response",
 				"status", resp.StatusCode,
 				"body_preview", redactTokens(string(body[:min(len(body), 500)])))
@@ -405,24 +429,27 @@ func (c *Client) apiRequest(ctx context.Context, params url.Values) (map[string]
 		}
 
 		c.logger.Debug("API response",
-			"status", resp.StatusCode,
+			"status", status,
 			"body_preview", redactTokens(string(body[:min(len(body), 500)])))
 
-		var result map[string]interface{}
-		if err := json.Unmarshal(body, &result); err != nil {
+		var raw interface{}
+		if err := json.Unmarshal(body, &raw); err != nil {
 			return nil, fmt.Errorf("failed to parse response: %w", err)
 		}
+		result, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected API response: expected a JSON object, got %s", jsonKind(raw))
+		}
 
 		// Check for API errors
-		if errObj, ok := result["error"].(map[string]interface{}); ok {
-			code, _ := errObj["code"].(string)
-			info, _ := errObj["info"].(string)
+		if apiErr := responseAPIError(result); apiErr != nil {
 			duration := time.Since(start).Seconds()
-			metrics.RecordAPICall(action, duration, false, code)
+			metrics.RecordAPICall(action, duration, false, string(apiErr.Code))
 			// API errors don't indicate connectivity issues, so record success for circuit breaker
 			c.circuitBreaker.RecordSuccess()
-			return nil, fmt.Errorf("API error [%s]: %s", code, info)
+			return nil, apiErr
 		}
+		c.logAPIWarnings(action, result)
 
 		duration := time.Since(start).Seconds()
 		metrics.RecordAPICall(action, duration, true, "")
@@ -436,6 +463,75 @@ func (c *Client) apiRequest(ctx context.Context, params url.Values) (map[string]
 	return nil, lastErr
 }
 
+// responseAPIError returns the MediaWiki error object in an API response as
+// an *APIError, or nil when the response carries none. A malformed error
+// value (not an object) is still reported rather than ignored.
+func responseAPIError(result map[string]interface{}) *APIError {
+	switch errVal := result["error"].(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		return NewMediaWikiAPIError(getString(errVal["code"]), getString(errVal["info"]))
+	default:
+		return NewMediaWikiAPIError("", fmt.Sprint(errVal))
+	}
+}
+
+// logAPIWarnings logs the per-module warnings MediaWiki attaches to otherwise
+// successful responses (deprecated parameters, truncated results and so on).
+func (c *Client) logAPIWarnings(action string, result map[string]interface{}) {
+	for module, w := range getMap(result["warnings"]) {
+		c.logger.Debug("API warning", "action", action, "module", module, "warning", warningText(w))
+	}
+}
+
+// apiWarnings returns a response's warnings as "module: text" strings,
+// sorted by module, for results that pass them on to the caller.
+func apiWarnings(result map[string]interface{}) []string {
+	warnings := getMap(result["warnings"])
+	modules := make([]string, 0, len(warnings))
+	for module := range warnings {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	var out []string
+	for _, module := range modules {
+		out = append(out, module+": "+warningText(warnings[module]))
+	}
+	return out
+}
+
+// warningText extracts the message from one module's warnings entry.
+func warningText(w interface{}) string {
+	entry := getMap(w)
+	text := getString(entry["warnings"]) // formatversion=2
+	if text == "" {
+		text = getString(entry["*"])
+	}
+	if text == "" {
+		text = fmt.Sprint(w)
+	}
+	return text
+}
+
+// jsonKind names the JSON type of a decoded value for error messages.
+func jsonKind(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
 // checkExistingSession verifies if we're already logged in via existing cookies
 // Returns true if already authenticated, false otherwise
 // resetCookies clears all cookies to allow fresh login
@@ -445,7 +541,6 @@ func (c *Client) apiRequest(ctx context.Context, params url.Values) (map[string]
 // getCSRFToken gets a CSRF token for editing
 // after use, so we must not reuse them across edit requests.
 // EnsureLoggedIn ensures the client is logged in (for wikis requiring auth for read)
-// truncateContent truncates content if it exceeds the limit
 
 // redactTokens replaces token values in a JSON response string for safe debug logging.
 func redactTokens(s string) string {
@@ -151,3 +151,134 @@ func TestAPIError_BodySnippetTruncation(t *testing.T) {
 		t.Error("HG-2 regression: long body leaks via Error()")
 	}
 }
+
+// TestAPIRequest_MediaWikiErrorObject feeds MediaWiki error payloads and
+// malformed responses through public methods and asserts each surfaces a
+// descriptive error instead of panicking on the unexpected shape.
+func TestAPIRequest_MediaWikiErrorObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantCode ErrorCode
+		wantText string
+	}{
+		{"error object", `{"error":{"code":"readapidenied","info":"You need read permission to use this module."}}`, "readapidenied", "You need read permission"},
+		{"error without code", `{"error":{"info":"Something broke."}}`, APICodeUnknownError, "Something broke."},
+		{"error as string", `{"error":"internal_api_error"}`, APICodeUnknownError, "internal_api_error"},
+		{"top-level array", `[]`, "", "expected a JSON object, got array"},
+		{"top-level null", `null`, "", "expected a JSON object, got null"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.body))
+			})
+			defer server.Close()
+			client := createMockClient(t, server)
+			defer client.Close()
+
+			_, err := client.GetPageInfo(context.Background(), PageInfoArgs{Title: "Main Page"})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Errorf("error = %q, want it to mention %q", err, tt.wantText)
+			}
+			var apiErr *APIError
+			if tt.wantCode == "" {
+				if errors.As(err, &apiErr) {
+					t.Errorf("malformed response surfaced as *APIError: %v", apiErr)
+				}
+				return
+			}
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T: %v", err, err)
+			}
+			if apiErr.Code != tt.wantCode {
+				t.Errorf("Code = %q, want %q", apiErr.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAPIRequest_WarningsDoNotFail(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"warnings":{"main":{"*":"Unrecognized parameter: foo."}},"query":{"pages":{"1":{"pageid":1,"ns":0,"title":"Main Page","length":10}}}}`))
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	info, err := client.GetPageInfo(context.Background(), PageInfoArgs{Title: "Main Page"})
+	if err != nil {
+		t.Fatalf("GetPageInfo with warnings: %v", err)
+	}
+	if info.Title != "Main Page" {
+		t.Errorf("Title = %q, want Main Page", info.Title)
+	}
+}
+
+// TestReadMethods_MalformedResponses feeds every core read method an API
+// error object, non-JSON bodies and JSON of the wrong shape, and asserts each
+// returns an error rather than panicking on an unchecked type assertion.
+func TestReadMethods_MalformedResponses(t *testing.T) {
+	bodies := map[string]string{
+		"error object":  `{"error":{"code":"internal_api_error_DBQueryError","info":"A database query error has occurred."}}`,
+		"html page":     `<!DOCTYPE html><html><body>Service Unavailable</body></html>`,
+		"truncated":     `{"query":{"pages":{"1":{"title":"Ma`,
+		"wrong shapes":  `{"query":"oops","parse":[1,2],"continue":7}`,
+		"nested wrong":  `{"query":{"pages":[],"recentchanges":{},"general":"x","searchinfo":[]},"parse":{}}`,
+		"empty object":  `{}`,
+		"top-level str": `"ok"`,
+	}
+	methods := map[string]func(*Client) error{
+		"Search": func(c *Client) error {
+			_, err := c.Search(context.Background(), SearchArgs{Query: "test"})
+			return err
+		},
+		"GetPage": func(c *Client) error {
+			_, err := c.GetPage(context.Background(), GetPageArgs{Title: "Main Page"})
+			return err
+		},
+		"ListPages": func(c *Client) error {
+			_, err := c.ListPages(context.Background(), ListPagesArgs{Prefix: "A"})
+			return err
+		},
+		"GetPageInfo": func(c *Client) error {
+			_, err := c.GetPageInfo(context.Background(), PageInfoArgs{Title: "Main Page"})
+			return err
+		},
+		"GetRecentChanges": func(c *Client) error {
+			_, err := c.GetRecentChanges(context.Background(), RecentChangesArgs{})
+			return err
+		},
+		"Parse": func(c *Client) error {
+			_, err := c.Parse(context.Background(), ParseArgs{Wikitext: "''hi''"})
+			return err
+		},
+		"GetWikiInfo": func(c *Client) error {
+			_, err := c.GetWikiInfo(context.Background(), WikiInfoArgs{})
+			return err
+		},
+	}
+
+	for bodyName, body := range bodies {
+		for methodName, call := range methods {
+			t.Run(methodName+"/"+bodyName, func(t *testing.T) {
+				server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(body))
+				})
+				defer server.Close()
+				client := createMockClient(t, server)
+				defer client.Close()
+
+				if err := call(client); err == nil {
+					t.Errorf("%s accepted %s body without error", methodName, bodyName)
+				}
+			})
+		}
+	}
+}
@@ -207,20 +207,26 @@ func isBotPasswordSessionConflict(login map[string]interface{}) bool {
 }
 
 // markLoggedIn records a successful login and refreshes the token expiry.
+// A token cached from an earlier session is dropped, since MediaWiki ties
+// CSRF tokens to the session.
 func (c *Client) markLoggedIn(logMsg string) {
 	c.loggedIn = true
+	c.csrfToken = ""
 	c.tokenExpiry = time.Now().Add(60 * time.Minute)
 	c.logger.Info(logMsg, "username", c.config.Username)
 }
 
 func (c *Client) getCSRFToken(ctx context.Context) (string, error) {
-	c.mu.RLock()
-	if c.csrfToken != "" && time.Now().Before(c.tokenExpiry) {
-		token := c.csrfToken
-		c.mu.RUnlock()
+	if token, ok := c.cachedCSRFToken(); ok {
+		return token, nil
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	// Another write may have fetched a token while we waited.
+	if token, ok := c.cachedCSRFToken(); ok {
 		return token, nil
 	}
-	c.mu.RUnlock()
 
 	if err := c.login(ctx); err != nil {
 		return "", err
@@ -257,6 +263,16 @@ func (c *Client) getCSRFToken(ctx context.Context) (string, error) {
 	return csrfToken, nil
 }
 
+// cachedCSRFToken returns the cached CSRF token if it is still valid.
+func (c *Client) cachedCSRFToken() (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.csrfToken != "" && time.Now().Before(c.tokenExpiry) {
+		return c.csrfToken, true
+	}
+	return "", false
+}
+
 // invalidateCSRFToken clears the cached CSRF token so the next write
 // operation fetches a fresh one. MediaWiki can invalidate CSRF tokens
 func (c *Client) invalidateCSRFToken() {
@@ -265,6 +281,19 @@ func (c *Client) invalidateCSRFToken() {
 	c.mu.Unlock()
 }
 
+// retryOnBadToken runs a token-authenticated write and, if the wiki rejects
+// the cached CSRF token with badtoken, invalidates it and runs the write
+// exactly once more with a freshly fetched token.
+func retryOnBadToken[T any](c *Client, write func() (T, error)) (T, error) {
+	result, err := write()
+	if IsAPIErrorCode(err, "badtoken") {
+		c.logger.Info("CSRF token rejected, refreshing and retrying once")
+		c.invalidateCSRFToken()
+		result, err = write()
+	}
+	return result, err
+}
+
 func (c *Client) EnsureLoggedIn(ctx context.Context) error {
 	// Anonymous access: no credentials configured, skip authentication.
 	// Public wikis allow read operations without login.
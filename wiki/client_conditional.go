@@ -0,0 +1,70 @@
+package wiki
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// conditionalEntry holds the validators and body of a read response so a
+// later identical request can be sent as a conditional request and, on
+// 304 Not Modified, be answered from the stored body.
+type conditionalEntry struct {
+	ETag         string
+	LastModified string
+	Body         []byte
+}
+
+// conditionalCacheKey returns the response cache key for a request eligible
+// for conditional revalidation, or "" when it is not. Only read actions
+// qualify; token fetches and anything carrying a token never do, since a
+// stale token must not be replayed.
+func conditionalCacheKey(params url.Values) string {
+	switch params.Get("action") {
+	case "query", "parse":
+	default:
+		return ""
+	}
+	if params.Get("token") != "" || strings.Contains(params.Get("meta"), "tokens") {
+		return ""
+	}
+	return "conditional:" + params.Encode()
+}
+
+// applyConditionalHeaders adds If-None-Match / If-Modified-Since from a
+// stored response for key, returning the entry to serve on a 304.
+func (c *Client) applyConditionalHeaders(req *http.Request, key string) *conditionalEntry {
+	if key == "" {
+		return nil
+	}
+	cached, ok := c.getCached(key)
+	if !ok {
+		return nil
+	}
+	entry := cached.(*conditionalEntry)
+	if entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+	return entry
+}
+
+// resolveConditionalResponse returns the body and status to process for a
+// response. A 304 answering a conditional request yields the stored body
+// as a 200; a 200 carrying validators is stored for the next request.
+func (c *Client) resolveConditionalResponse(key string, entry *conditionalEntry, resp *http.Response, body []byte) ([]byte, int) {
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		c.logger.Debug("API response not modified, serving stored body")
+		return entry.Body, http.StatusOK
+	}
+	if resp.StatusCode == http.StatusOK && key != "" {
+		etag := resp.Header.Get("ETag")
+		lastModified := resp.Header.Get("Last-Modified")
+		if etag != "" || lastModified != "" {
+			c.setCache(key, &conditionalEntry{ETag: etag, LastModified: lastModified, Body: body}, "conditional")
+		}
+	}
+	return body, resp.StatusCode
+}
@@ -0,0 +1,91 @@
+package wiki
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAPIRequest_ConditionalNotModified(t *testing.T) {
+	var requests, notModified atomic.Int32
+	var gotIfNoneMatch, gotIfModifiedSince string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		gotIfNoneMatch = r.Header.Get("If-None-Match")
+		gotIfModifiedSince = r.Header.Get("If-Modified-Since")
+		if gotIfNoneMatch == `"rev-42"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"rev-42"`)
+		w.Header().Set("Last-Modified", "Mon, 12 Oct 2026 10:00:00 GMT")
+		_, _ = w.Write([]byte(`{"query":{"pages":{"1":{"title":"Large Page","length":123456}}}}`))
+	}))
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	params := func() url.Values {
+		p := url.Values{}
+		p.Set("action", "query")
+		p.Set("titles", "Large Page")
+		p.Set("prop", "info")
+		return p
+	}
+
+	first, err := client.apiRequest(context.Background(), params())
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	if gotIfNoneMatch != "" {
+		t.Errorf("first request sent If-None-Match %q, want none", gotIfNoneMatch)
+	}
+
+	second, err := client.apiRequest(context.Background(), params())
+	if err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+	if gotIfNoneMatch != `"rev-42"` || gotIfModifiedSince != "Mon, 12 Oct 2026 10:00:00 GMT" {
+		t.Errorf("second request validators = %q / %q, want stored ETag and Last-Modified", gotIfNoneMatch, gotIfModifiedSince)
+	}
+	if notModified.Load() != 1 {
+		t.Fatalf("server returned 304 %d times, want 1", notModified.Load())
+	}
+
+	title := func(resp map[string]interface{}) string {
+		pages := getMap(getMap(resp["query"])["pages"])
+		return getString(getMap(pages["1"])["title"])
+	}
+	if title(first) != "Large Page" || title(second) != "Large Page" {
+		t.Errorf("titles = %q / %q, want the stored body served for the 304", title(first), title(second))
+	}
+	if requests.Load() != 2 {
+		t.Errorf("requests = %d, want 2", requests.Load())
+	}
+}
+
+func TestConditionalCacheKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		params url.Values
+		want   bool
+	}{
+		{"query", url.Values{"action": {"query"}, "titles": {"A"}}, true},
+		{"parse", url.Values{"action": {"parse"}, "page": {"A"}}, true},
+		{"edit", url.Values{"action": {"edit"}, "title": {"A"}}, false},
+		{"token fetch", url.Values{"action": {"query"}, "meta": {"tokens"}}, false},
+		{"carries token", url.Values{"action": {"query"}, "token": {"abc"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conditionalCacheKey(tt.params) != ""; got != tt.want {
+				t.Errorf("conditionalCacheKey(%v) eligible = %v, want %v", tt.params, got, tt.want)
+			}
+		})
+	}
+}
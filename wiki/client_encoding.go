@@ -0,0 +1,45 @@
+package wiki
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// decodeResponseBody returns a reader over the decoded response body.
+//
+// Go's transport requests gzip and decodes it transparently (setting
+// resp.Uncompressed) as long as the caller never sets Accept-Encoding
+// itself. This handles everything else: a request that set the header
+// manually, or a proxy that compresses regardless. Both zlib-wrapped and
+// raw deflate are accepted, since servers disagree on what "deflate" means.
+func decodeResponseBody(resp *http.Response) (io.Reader, error) {
+	if resp.Uncompressed {
+		return resp.Body, nil
+	}
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "", "identity":
+		return resp.Body, nil
+	case "gzip", "x-gzip":
+		return gzip.NewReader(resp.Body)
+	case "deflate":
+		br := bufio.NewReader(resp.Body)
+		if header, err := br.Peek(2); err == nil && isZlibHeader(header) {
+			return zlib.NewReader(br)
+		}
+		return flate.NewReader(br), nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", resp.Header.Get("Content-Encoding"))
+	}
+}
+
+// isZlibHeader reports whether b starts with a zlib stream header (RFC 1950):
+// deflate compression method and a header checksum divisible by 31.
+func isZlibHeader(b []byte) bool {
+	return b[0]&0x0f == 8 && (uint16(b[0])<<8|uint16(b[1]))%31 == 0
+}
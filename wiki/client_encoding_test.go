@@ -0,0 +1,107 @@
+package wiki
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const encodingTestBody = `{"query":{"general":{"sitename":"Compressed Wiki"}}}`
+
+func compress(t *testing.T, encoding string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "zlib":
+		w = zlib.NewWriter(&buf)
+	case "flate":
+		fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w = fw
+	}
+	if _, err := w.Write([]byte(encodingTestBody)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestAPIRequest_GzipResponse(t *testing.T) {
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(compress(t, "gzip"))
+	}))
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	resp, err := client.apiRequest(context.Background(), url.Values{"action": {"query"}, "meta": {"siteinfo"}})
+	if err != nil {
+		t.Fatalf("apiRequest: %v", err)
+	}
+	if !strings.Contains(acceptEncoding, "gzip") {
+		t.Errorf("Accept-Encoding = %q, want gzip", acceptEncoding)
+	}
+	if got := getString(getMap(getMap(resp["query"])["general"])["sitename"]); got != "Compressed Wiki" {
+		t.Errorf("sitename = %q, want decoded body", got)
+	}
+}
+
+func TestReadBoundedBody_ContentEncoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding string
+		body     []byte
+	}{
+		{"identity", "", []byte(encodingTestBody)},
+		{"gzip", "gzip", compress(t, "gzip")},
+		{"zlib deflate", "deflate", compress(t, "zlib")},
+		{"raw deflate", "deflate", compress(t, "flate")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Simulates a request that set Accept-Encoding manually, where the
+			// transport leaves the body compressed.
+			resp := &http.Response{
+				Header: http.Header{},
+				Body:   io.NopCloser(bytes.NewReader(tt.body)),
+			}
+			if tt.encoding != "" {
+				resp.Header.Set("Content-Encoding", tt.encoding)
+			}
+			got, err := readBoundedBody(resp)
+			if err != nil {
+				t.Fatalf("readBoundedBody: %v", err)
+			}
+			if string(got) != encodingTestBody {
+				t.Errorf("body = %q, want %q", got, encodingTestBody)
+			}
+		})
+	}
+
+	resp := &http.Response{
+		Header: http.Header{"Content-Encoding": {"br"}},
+		Body:   io.NopCloser(strings.NewReader("x")),
+	}
+	if _, err := readBoundedBody(resp); err == nil {
+		t.Error("expected an error for an unsupported encoding")
+	}
+}
@@ -4,15 +4,22 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/unicode/norm"
 )
 
+// truncateContent truncates content to at most limit bytes, appending a
+// truncation notice. The cut point backs off to a rune boundary so multi-byte
+// UTF-8 characters are never split.
 func truncateContent(content string, limit int) (string, bool) {
 	if len(content) <= limit {
 		return content, false
 	}
 
+	kept := truncateAtRuneBoundary(content, limit)
+
 	truncationMsg := fmt.Sprintf(`
 
 ---
@@ -23,9 +30,25 @@ To get the full content:
 1. Request specific sections using the 'section' parameter
 2. Use mediawiki_get_page_info to check the full page size first
 3. For very large pages, consider fetching in chunks`,
-		limit, len(content), float64(limit)/float64(len(content))*100)
+		len(kept), len(content), float64(len(kept))/float64(len(content))*100)
 
-	return content[:limit] + truncationMsg, true
+	return kept + truncationMsg, true
+}
+
+// truncateAtRuneBoundary returns the longest prefix of s that is at most
+// maxBytes long and does not end inside a multi-byte UTF-8 sequence.
+func truncateAtRuneBoundary(s string, maxBytes int) string {
+	if maxBytes <= 0 {
+		return ""
+	}
+	if len(s) <= maxBytes {
+		return s
+	}
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
 }
 
 // normalizeLimit ensures limit is within bounds
@@ -39,13 +62,28 @@ func normalizeLimit(limit, defaultVal, maxVal int) int {
 	return limit
 }
 
-// normalizeCategoryName ensures category name has proper prefix
+// DefaultMaxIssuesPerPage is how many issues the terminology, spelling and
+// broken-link checks list per page before marking the page truncated.
+const DefaultMaxIssuesPerPage = 100
+
+// maxIssuesPerPageLimit bounds a caller-supplied per-page issue cap.
+const maxIssuesPerPageLimit = 1000
+
+// normalizeCategoryName returns the canonical "Category:Foo Bar" form of a
+// category given as "Foo Bar", "Foo_Bar", "category:foo" or "Category:Foo":
+// the prefix is added or recased, underscores become spaces, runs of
+// whitespace collapse, and the first letter of the name is capitalized
+// (MediaWiki's default).
 func normalizeCategoryName(name string) string {
 	name = strings.TrimSpace(name)
-	if !strings.HasPrefix(name, "Category:") {
-		name = "Category:" + name
+	if len(name) >= len("Category:") && strings.EqualFold(name[:len("Category:")], "Category:") {
+		name = name[len("Category:"):]
+	}
+	name = strings.Join(strings.Fields(strings.ReplaceAll(name, "_", " ")), " ")
+	if r, size := utf8.DecodeRuneInString(name); size > 0 {
+		name = string(unicode.ToUpper(r)) + name[size:]
 	}
-	return name
+	return "Category:" + name
 }
 
 // NormalizeUnicode applies NFC normalization to a string
@@ -10,6 +10,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -538,6 +539,79 @@ func TestInvalidateCSRFToken(t *testing.T) {
 	}
 }
 
+// newTokenCountingServer serves an existing session, CSRF tokens, and
+// successful edits, counting the token fetches.
+func newTokenCountingServer(t *testing.T, fetches *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("meta") == "userinfo":
+			_, _ = w.Write([]byte(`{"query":{"userinfo":{"id":1,"name":"TestUser"}}}`))
+		case r.FormValue("meta") == "tokens":
+			fetches.Add(1)
+			_, _ = w.Write([]byte(`{"query":{"tokens":{"csrftoken":"cached-csrf-token"}}}`))
+		case r.FormValue("action") == "edit":
+			_, _ = w.Write([]byte(`{"edit":{"result":"Success","pageid":1,"title":"Test Page","newrevid":2}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+}
+
+func TestGetCSRFToken_ReusedAcrossEdits(t *testing.T) {
+	var fetches atomic.Int32
+	server := newTokenCountingServer(t, &fetches)
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.EditPage(context.Background(), EditPageArgs{Title: "Test Page", Content: "Content"}); err != nil {
+			t.Fatalf("edit %d: %v", i+1, err)
+		}
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("CSRF token fetched %d times for two edits, want 1", got)
+	}
+}
+
+func TestGetCSRFToken_ConcurrentWritesShareOneFetch(t *testing.T) {
+	var fetches atomic.Int32
+	server := newTokenCountingServer(t, &fetches)
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.getCSRFToken(context.Background()); err != nil {
+				t.Errorf("getCSRFToken: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("CSRF token fetched %d times by concurrent writers, want 1", got)
+	}
+}
+
+func TestMarkLoggedIn_DropsStaleCSRFToken(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	client.csrfToken = "old-session-token"
+	client.tokenExpiry = time.Now().Add(time.Hour)
+	client.markLoggedIn("test login")
+
+	if client.csrfToken != "" {
+		t.Errorf("csrfToken = %q after a new login, want it cleared", client.csrfToken)
+	}
+}
+
 func TestEnsureLoggedIn_AlreadyLoggedIn(t *testing.T) {
 	client := createTestClient(t)
 	defer client.Close()
@@ -1590,3 +1664,43 @@ func TestIsLoggedIn(t *testing.T) {
 		t.Error("Expected isLoggedIn() to return true after setting loggedIn=true")
 	}
 }
+
+func TestClientSendsConfiguredUserAgent(t *testing.T) {
+	var gotUserAgents []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gotUserAgents = append(gotUserAgents, r.Header.Get("User-Agent"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"query":{"general":{"sitename":"Test Wiki"}}}`))
+	}))
+	defer server.Close()
+
+	const userAgent = "DocsBot/2.1 (https://example.com/bot; ops@example.com)"
+	client := NewClient(&Config{
+		BaseURL:    server.URL,
+		Timeout:    5 * time.Second,
+		MaxRetries: 0,
+		UserAgent:  userAgent,
+	}, slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+	defer client.Close()
+
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("meta", "siteinfo")
+	if _, err := client.apiRequest(context.Background(), params); err != nil {
+		t.Fatalf("apiRequest: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(gotUserAgents) == 0 {
+		t.Fatal("no request reached the server")
+	}
+	for _, got := range gotUserAgents {
+		if got != userAgent {
+			t.Errorf("User-Agent = %q, expected %q", got, userAgent)
+		}
+	}
+}
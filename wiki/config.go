@@ -28,6 +28,72 @@ type Config struct {
 
 	// MaxRetries for failed requests
 	MaxRetries int
+
+	// DryRun makes edit, move and upload operations report what they would
+	// do without issuing the mutating API call
+	DryRun bool
+
+	// MaxEditSizeBytes rejects edits whose new content exceeds this size
+	// (0 disables the check)
+	MaxEditSizeBytes int
+
+	// MaxEditDeltaBytes rejects whole-page edits that grow or shrink the page
+	// by more than this many bytes versus the current revision (0 disables
+	// the check)
+	MaxEditDeltaBytes int
+
+	// BlankingThresholdPercent is how much of a page (in percent) a
+	// whole-page edit may remove before it is refused as accidental blanking
+	// unless AllowBlanking is set. 0 uses DefaultBlankingThresholdPercent.
+	BlankingThresholdPercent int
+
+	// EditableNamespaces restricts edits and moves to pages in these
+	// namespace IDs, keeping sensitive namespaces such as MediaWiki: (8) and
+	// Module: (828) out of reach. Empty allows every namespace.
+	EditableNamespaces []int
+
+	// MaxResponseBytes caps the marshaled size of a tool result; larger
+	// results have trailing list items dropped to fit (0 disables the cap)
+	MaxResponseBytes int
+}
+
+// DefaultUserAgent is sent when MEDIAWIKI_USER_AGENT is unset. It names the
+// project but not the operator; public wikis such as Wikimedia's ask for
+// contact details in the User-Agent, which MEDIAWIKI_CONTACT adds.
+const DefaultUserAgent = "MediaWikiMCPServer/1.0 (https://github.com/olgasafonova/mediawiki-mcp-server)"
+
+// HasGenericUserAgent reports whether the client would identify itself with
+// the bare DefaultUserAgent, giving wiki operators no way to reach whoever
+// runs it.
+func (c *Config) HasGenericUserAgent() bool {
+	return c.UserAgent == "" || c.UserAgent == DefaultUserAgent
+}
+
+// userAgentWithContact adds contact (an email address or URL) to the
+// parenthesized comment of userAgent, following the
+// "Name/version (url; contact)" form Wikimedia's User-Agent policy asks for.
+// An empty contact, or one the User-Agent already contains, leaves it as is.
+func userAgentWithContact(userAgent, contact string) string {
+	contact = strings.TrimSpace(contact)
+	if contact == "" || strings.Contains(userAgent, contact) {
+		return userAgent
+	}
+	if strings.HasSuffix(userAgent, ")") {
+		return strings.TrimSuffix(userAgent, ")") + "; " + contact + ")"
+	}
+	return userAgent + " (" + contact + ")"
+}
+
+// DefaultBlankingThresholdPercent is the blanking guard threshold used when
+// Config.BlankingThresholdPercent is unset.
+const DefaultBlankingThresholdPercent = 90
+
+// blankingThreshold returns the effective blanking guard threshold.
+func (c *Config) blankingThreshold() int {
+	if c.BlankingThresholdPercent > 0 {
+		return c.BlankingThresholdPercent
+	}
+	return DefaultBlankingThresholdPercent
 }
 
 // ConfigError provides detailed configuration errors with recovery suggestions
@@ -104,7 +170,31 @@ Examples:
 
 	userAgent := os.Getenv("MEDIAWIKI_USER_AGENT")
 	if userAgent == "" {
-		userAgent = "MediaWikiMCPServer/1.0 (https://github.com/olgasafonova/mediawiki-mcp-server)"
+		userAgent = DefaultUserAgent
+	}
+	userAgent = userAgentWithContact(userAgent, os.Getenv("MEDIAWIKI_CONTACT"))
+
+	dryRun, _ := strconv.ParseBool(os.Getenv("MEDIAWIKI_DRY_RUN"))
+
+	maxEditSize, err := loadByteLimit("MEDIAWIKI_MAX_EDIT_SIZE_BYTES")
+	if err != nil {
+		return nil, err
+	}
+	maxEditDelta, err := loadByteLimit("MEDIAWIKI_MAX_EDIT_DELTA_BYTES")
+	if err != nil {
+		return nil, err
+	}
+	blankingThreshold, err := loadBlankingThreshold()
+	if err != nil {
+		return nil, err
+	}
+	editableNamespaces, err := loadEditableNamespaces()
+	if err != nil {
+		return nil, err
+	}
+	maxResponse, err := loadByteLimit("MEDIAWIKI_MAX_RESPONSE_BYTES")
+	if err != nil {
+		return nil, err
 	}
 
 	return &Config{
@@ -114,9 +204,86 @@ Examples:
 		Timeout:    timeout,
 		UserAgent:  userAgent,
 		MaxRetries: maxRetries,
+		DryRun:     dryRun,
+
+		MaxEditSizeBytes:  maxEditSize,
+		MaxEditDeltaBytes: maxEditDelta,
+
+		BlankingThresholdPercent: blankingThreshold,
+		EditableNamespaces:       editableNamespaces,
+		MaxResponseBytes:         maxResponse,
 	}, nil
 }
 
+// loadEditableNamespaces reads MEDIAWIKI_EDITABLE_NAMESPACES, a
+// comma-separated list of namespace IDs. Unset means no restriction.
+func loadEditableNamespaces() ([]int, error) {
+	v := strings.TrimSpace(os.Getenv("MEDIAWIKI_EDITABLE_NAMESPACES"))
+	if v == "" {
+		return nil, nil
+	}
+	var namespaces []int
+	for _, field := range strings.Split(v, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || n < 0 {
+			return nil, &ConfigError{
+				Field:   "MEDIAWIKI_EDITABLE_NAMESPACES",
+				Message: fmt.Sprintf("must be a comma-separated list of namespace IDs, got: %q", v),
+				Suggestion: `List the namespace IDs the server may edit, or unset it to allow all.
+
+Examples:
+  export MEDIAWIKI_EDITABLE_NAMESPACES="0"        # Main namespace only
+  export MEDIAWIKI_EDITABLE_NAMESPACES="0,2,4"    # Main, User and Project`,
+			}
+		}
+		namespaces = append(namespaces, n)
+	}
+	return namespaces, nil
+}
+
+// loadBlankingThreshold reads MEDIAWIKI_BLANKING_THRESHOLD_PERCENT, a
+// percentage between 1 and 100. Unset means 0 (use the default).
+func loadBlankingThreshold() (int, error) {
+	v := os.Getenv("MEDIAWIKI_BLANKING_THRESHOLD_PERCENT")
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 || n > 100 {
+		return 0, &ConfigError{
+			Field:   "MEDIAWIKI_BLANKING_THRESHOLD_PERCENT",
+			Message: fmt.Sprintf("must be an integer between 1 and 100, got: %q", v),
+			Suggestion: `Set the percentage of a page an edit may remove before it is refused.
+
+Examples:
+  export MEDIAWIKI_BLANKING_THRESHOLD_PERCENT="90"   # Default
+  export MEDIAWIKI_BLANKING_THRESHOLD_PERCENT="100"  # Only refuse edits that empty the page`,
+		}
+	}
+	return n, nil
+}
+
+// loadByteLimit reads an optional non-negative byte count from the named
+// environment variable. Unset means 0 (no limit).
+func loadByteLimit(name string) (int, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, &ConfigError{
+			Field:   name,
+			Message: fmt.Sprintf("must be a non-negative integer, got: %q", v),
+			Suggestion: fmt.Sprintf(`Set a byte count, or 0 to disable the limit.
+
+Example:
+  export %s="100000"`, name),
+		}
+	}
+	return n, nil
+}
+
 // validateWikiURLScheme accepts only https, or http when allowInsecure is true.
 // Without opt-in, any non-https scheme returns the HTTPS-required error — that
 // covers the common case of plain hostnames where url.Parse leaves the scheme
@@ -210,7 +377,7 @@ func LoadConfigOrUnconfigured() (*Config, error) {
 	if configErr, ok := err.(*ConfigError); ok && configErr.Field == "MEDIAWIKI_URL" && configErr.Message == "environment variable is required but not set" {
 		return &Config{
 			Timeout:    30 * time.Second,
-			UserAgent:  "MediaWikiMCPServer/1.0 (https://github.com/olgasafonova/mediawiki-mcp-server)",
+			UserAgent:  userAgentWithContact(DefaultUserAgent, os.Getenv("MEDIAWIKI_CONTACT")),
 			MaxRetries: 3,
 		}, nil
 	}
@@ -2,6 +2,7 @@ package wiki
 
 import (
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -167,3 +168,49 @@ func TestHasCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestUserAgentWithContact(t *testing.T) {
+	tests := []struct {
+		name      string
+		userAgent string
+		contact   string
+		expected  string
+	}{
+		{"No contact", DefaultUserAgent, "", DefaultUserAgent},
+		{"Added to comment", DefaultUserAgent, "ops@example.com",
+			"MediaWikiMCPServer/1.0 (https://github.com/olgasafonova/mediawiki-mcp-server; ops@example.com)"},
+		{"No comment yet", "DocsBot/2.1", " https://example.com/bot ", "DocsBot/2.1 (https://example.com/bot)"},
+		{"Already present", "DocsBot/2.1 (ops@example.com)", "ops@example.com", "DocsBot/2.1 (ops@example.com)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userAgentWithContact(tt.userAgent, tt.contact); got != tt.expected {
+				t.Errorf("userAgentWithContact(%q, %q) = %q, expected %q", tt.userAgent, tt.contact, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_UserAgentContact(t *testing.T) {
+	t.Setenv("MEDIAWIKI_URL", "https://wiki.example.com/api.php")
+	t.Setenv("MEDIAWIKI_USER_AGENT", "")
+	t.Setenv("MEDIAWIKI_CONTACT", "")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !cfg.HasGenericUserAgent() {
+		t.Errorf("UserAgent %q should be reported as the generic default", cfg.UserAgent)
+	}
+
+	t.Setenv("MEDIAWIKI_CONTACT", "ops@example.com")
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.HasGenericUserAgent() || !strings.Contains(cfg.UserAgent, "ops@example.com") {
+		t.Errorf("UserAgent = %q, expected the default with the contact added", cfg.UserAgent)
+	}
+}
@@ -0,0 +1,152 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// dryRunEdit builds the EditResult for an edit skipped by Config.DryRun.
+// Whole-page edits include a Markdown diff against the current revision,
+// computed with the read-only compare API; section, append and prepend
+// edits only report the target, since the new text does not describe the
+// full page.
+func (c *Client) dryRunEdit(ctx context.Context, args EditPageArgs, extra url.Values) EditResult {
+	section := args.Section
+	if s := extra.Get("section"); s != "" {
+		section = s
+	}
+
+	result := EditResult{
+		Success: true,
+		Title:   args.Title,
+		PageURL: c.pageURL(ctx, args.Title),
+		DryRun:  true,
+		Message: "Dry run: edit not saved",
+	}
+	if section == "" && !args.addsText() {
+		diff, newPage, err := c.dryRunDiff(ctx, args.Title, args.Content)
+		if err != nil {
+			c.logger.Debug("Dry-run diff unavailable", "title", args.Title, "error", err)
+		}
+		result.Diff = diff
+		result.NewPage = newPage
+	}
+
+	op := AuditOpEdit
+	if result.NewPage {
+		op = AuditOpCreate
+		result.Message = "Dry run: page not created"
+	}
+	c.logDryRun(op, args.Title, args.submittedText(), args.Summary)
+	return result
+}
+
+// dryRunDiff compares the current page text with content and renders the
+// result as a Markdown diff. A missing page reports newPage=true.
+func (c *Client) dryRunDiff(ctx context.Context, title, content string) (diff string, newPage bool, err error) {
+	params := url.Values{}
+	params.Set("action", "compare")
+	params.Set("fromtitle", title)
+	params.Set("toslots", "main")
+	params.Set("totext-main", content)
+	params.Set("tocontentmodel-main", "wikitext")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		if strings.Contains(err.Error(), "missingtitle") {
+			return "", true, nil
+		}
+		return "", false, err
+	}
+	compare, ok := resp["compare"].(map[string]interface{})
+	if !ok {
+		return "", false, fmt.Errorf("compare not found in response")
+	}
+	diff, _, _ = renderDiffMarkdown(getString(compare["*"]))
+	return diff, false, nil
+}
+
+// dryRunMove builds the MovePageResult for a move skipped by Config.DryRun.
+func (c *Client) dryRunMove(args MovePageArgs) MovePageResult {
+	c.logDryRun(AuditOpMove, args.From+" → "+args.To, "", args.Reason)
+	return MovePageResult{
+		Success: true,
+		From:    args.From,
+		To:      args.To,
+		Reason:  args.Reason,
+		DryRun:  true,
+		Message: fmt.Sprintf("Dry run: page would be moved from '%s' to '%s'", args.From, args.To),
+	}
+}
+
+// dryRunDelete builds the DeletePageResult for a deletion skipped by
+// Config.DryRun, reporting how many revisions would have gone.
+func (c *Client) dryRunDelete(args DeletePageArgs, revisions int, capped bool) DeletePageResult {
+	c.logDryRun(AuditOpDelete, args.Title, "", args.Reason)
+	return DeletePageResult{
+		Success:          true,
+		Title:            args.Title,
+		Reason:           args.Reason,
+		RevisionsDeleted: revisions,
+		RevisionsCapped:  capped,
+		DryRun:           true,
+		Message:          fmt.Sprintf("Dry run: page '%s' would be deleted (%s)", args.Title, describeRevisionCount(revisions, capped)),
+	}
+}
+
+// dryRunUndelete builds the UndeletePageResult for a restore skipped by
+// Config.DryRun.
+func (c *Client) dryRunUndelete(args UndeletePageArgs) UndeletePageResult {
+	c.logDryRun(AuditOpUndelete, args.Title, "", args.Reason)
+	return UndeletePageResult{
+		Success: true,
+		Title:   args.Title,
+		Reason:  args.Reason,
+		DryRun:  true,
+		Message: fmt.Sprintf("Dry run: page '%s' would be restored", args.Title),
+	}
+}
+
+// dryRunProtect builds the ProtectPageResult for a protection change
+// skipped by Config.DryRun. Applied lists the requested protections, as
+// MediaWiki would not report them until the change is made.
+func (c *Client) dryRunProtect(args ProtectPageArgs, before []string, applied []AppliedProtection) ProtectPageResult {
+	c.logDryRun(AuditOpProtect, args.Title, "", args.Reason)
+	return ProtectPageResult{
+		Success: true,
+		Title:   args.Title,
+		Reason:  args.Reason,
+		Before:  before,
+		Applied: applied,
+		Cascade: args.Cascade,
+		DryRun:  true,
+		Message: fmt.Sprintf("Dry run: protection of '%s' would change to %s", args.Title, describeProtections(applied)),
+	}
+}
+
+// dryRunUpload builds the UploadFileResult for an upload skipped by
+// Config.DryRun. Only caller-supplied bytes have a known size; URL uploads
+// are never fetched.
+func (c *Client) dryRunUpload(args UploadFileArgs) UploadFileResult {
+	c.logDryRun(AuditOpUpload, "File:"+args.Filename, args.FileURL+string(args.FileData), args.Comment)
+	source := args.FileURL
+	if source == "" {
+		source = fmt.Sprintf("%d bytes of file data", len(args.FileData))
+	}
+	return UploadFileResult{
+		Success:  true,
+		Filename: args.Filename,
+		Size:     len(args.FileData),
+		DryRun:   true,
+		Message:  fmt.Sprintf("Dry run: would upload %s as 'File:%s'", source, args.Filename),
+	}
+}
+
+// logDryRun records a dry_run audit entry for a skipped write.
+func (c *Client) logDryRun(op AuditOperation, title, content, summary string) {
+	entry := c.buildAuditEntry(AuditOpDryRun, title, content, summary, false, false, true, 0, 0, "")
+	entry.DryRunOf = op
+	c.logAudit(entry)
+}
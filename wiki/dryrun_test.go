@@ -0,0 +1,129 @@
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDryRun_NoWriteAPICalls(t *testing.T) {
+	var writes atomic.Int32
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "edit", "move", "upload", "delete", "undelete", "protect":
+			writes.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{})
+		case "compare":
+			if r.FormValue("fromtitle") == "New Page" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"error": map[string]interface{}{"code": "missingtitle", "info": "The page you specified doesn't exist."},
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"compare": map[string]interface{}{
+					"*": `<tr><td class="diff-marker" data-marker="−"></td><td class="diff-deletedline"><div>Old text</div></td>` +
+						`<td class="diff-marker" data-marker="+"></td><td class="diff-addedline"><div>New text</div></td></tr>`,
+				},
+			})
+		default:
+			if r.FormValue("prop") == "revisions" {
+				_, _ = w.Write([]byte(`{"query":{"pages":{"1":{"pageid":1,"title":"Old","revisions":[{"revid":2},{"revid":1}]}}}}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{"general": map[string]interface{}{}},
+			})
+		}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	client.config.DryRun = true
+	var audit bytes.Buffer
+	client.SetAuditLogger(NewWriterAuditLogger(&audit, client.logger))
+	ctx := context.Background()
+
+	edit, err := client.EditPage(ctx, EditPageArgs{Title: "Existing", Content: "New text", Summary: "update"})
+	if err != nil {
+		t.Fatalf("EditPage: %v", err)
+	}
+	if !edit.Success || !edit.DryRun || edit.NewPage {
+		t.Errorf("EditPage result = %+v, want successful dry run of an existing page", edit)
+	}
+	if !strings.Contains(edit.Diff, "+New text") || !strings.Contains(edit.Diff, "-Old text") {
+		t.Errorf("EditPage diff = %q, want removed and added lines", edit.Diff)
+	}
+
+	created, err := client.EditPage(ctx, EditPageArgs{Title: "New Page", Content: "Hello"})
+	if err != nil {
+		t.Fatalf("EditPage (new page): %v", err)
+	}
+	if !created.NewPage || created.Diff != "" {
+		t.Errorf("EditPage (new page) = %+v, want NewPage without diff", created)
+	}
+
+	move, err := client.MovePage(ctx, MovePageArgs{From: "Old", To: "New"})
+	if err != nil {
+		t.Fatalf("MovePage: %v", err)
+	}
+	if !move.DryRun || move.From != "Old" || move.To != "New" {
+		t.Errorf("MovePage result = %+v, want dry run from Old to New", move)
+	}
+
+	upload, err := client.UploadFile(ctx, UploadFileArgs{Filename: "Logo.png", FileData: []byte("png")})
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if !upload.DryRun || upload.Size != 3 {
+		t.Errorf("UploadFile result = %+v, want dry run of 3 bytes", upload)
+	}
+
+	deleted, err := client.DeletePage(ctx, DeletePageArgs{Title: "Old"})
+	if err != nil {
+		t.Fatalf("DeletePage: %v", err)
+	}
+	if !deleted.DryRun || deleted.RevisionsDeleted != 2 {
+		t.Errorf("DeletePage result = %+v, want dry run of 2 revisions", deleted)
+	}
+
+	restored, err := client.UndeletePage(ctx, UndeletePageArgs{Title: "Old"})
+	if err != nil {
+		t.Fatalf("UndeletePage: %v", err)
+	}
+	if !restored.DryRun {
+		t.Errorf("UndeletePage result = %+v, want dry run", restored)
+	}
+
+	protected, err := client.ProtectPage(ctx, ProtectPageArgs{Title: "Old", Protections: map[string]string{"edit": "sysop"}})
+	if err != nil {
+		t.Fatalf("ProtectPage: %v", err)
+	}
+	if !protected.DryRun || len(protected.Applied) != 1 {
+		t.Errorf("ProtectPage result = %+v, want dry run of one protection", protected)
+	}
+
+	if n := writes.Load(); n != 0 {
+		t.Errorf("write API calls = %d, want 0", n)
+	}
+
+	var ops []string
+	for _, line := range strings.Split(strings.TrimSpace(audit.String()), "\n") {
+		var entry AuditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("audit line %q: %v", line, err)
+		}
+		if entry.Operation != AuditOpDryRun {
+			t.Errorf("audit operation = %q, want %q", entry.Operation, AuditOpDryRun)
+		}
+		ops = append(ops, string(entry.DryRunOf))
+	}
+	if got, want := strings.Join(ops, ","), "edit,create,move,upload,delete,undelete,protect"; got != want {
+		t.Errorf("dry_run_of sequence = %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,7 @@
 package wiki
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -37,6 +38,9 @@ const (
 	APICodeClientError ErrorCode = "API_CLIENT_ERROR" // 4xx (non-429)
 	APICodeServerError ErrorCode = "API_SERVER_ERROR" // 5xx
 	APICodeUnknown     ErrorCode = "API_UNKNOWN"      // 1xx, 2xx-non-200, anything else
+
+	// APICodeUnknownError is given to a MediaWiki error object without a code
+	APICodeUnknownError ErrorCode = "unknown_error"
 )
 
 // SSRFError represents a blocked SSRF attempt with structured error code
@@ -337,6 +341,18 @@ func NewBatchTooLargeError(requested, max int) *WikiError {
 	}
 }
 
+// NewPermissionDeniedError creates an error for an operation the wiki
+// account is not allowed to perform because it lacks the named user right
+func NewPermissionDeniedError(operation, right string) *WikiError {
+	return &WikiError{
+		Code:       string(AuthCodePermissionDenied),
+		Message:    fmt.Sprintf("Permission denied: %s requires the '%s' right", operation, right),
+		Details:    fmt.Sprintf("The wiki account used by this server is not in a group that grants '%s' (usually sysop).", right),
+		Suggestion: "Ask a wiki administrator to grant the right to the bot account, or use an account that has it",
+		Input:      right,
+	}
+}
+
 // WrapAPIError wraps a MediaWiki API error with helpful context
 func WrapAPIError(code, info, operation string) *WikiError {
 	err := &WikiError{
@@ -369,6 +385,10 @@ func WrapAPIError(code, info, operation string) *WikiError {
 	case "assertuserfailed":
 		err.Suggestion = "Login session expired. Re-authentication will be attempted automatically."
 
+	case "articleexists":
+		err.Suggestion = "Choose a different target title, or move the existing page out of the way first."
+		err.Alternatives = []string{"mediawiki_get_page_info - inspect the existing target page"}
+
 	case "editconflict":
 		err.Suggestion = "Another edit was made while you were editing. Fetch the latest version and reapply changes."
 		err.Alternatives = []string{"mediawiki_get_page - get current content", "mediawiki_get_revisions - see recent edits"}
@@ -396,9 +416,15 @@ func WrapAPIError(code, info, operation string) *WikiError {
 // message; the body is preserved on the struct via BodySnippet (capped
 // to APIErrorBodyMax bytes) for diagnostic logging by the SERVER, never
 // surfaced to MCP callers.
+//
+// The same type carries MediaWiki error objects ({"error":{"code":...}})
+// returned with HTTP 200: Code is then the MediaWiki error code (e.g.
+// "badtoken") and Info its message, which is safe to surface.
 type APIError struct {
 	StatusCode int
 	Code       ErrorCode
+	// Info is the MediaWiki error message; empty for HTTP-level errors.
+	Info string
 	// BodySnippet is the truncated, server-only body capture for logging.
 	// It is NOT included in Error() output. Length capped at APIErrorBodyMax.
 	BodySnippet string
@@ -410,6 +436,9 @@ type APIError struct {
 const APIErrorBodyMax = 256
 
 func (e *APIError) Error() string {
+	if e.Info != "" {
+		return fmt.Sprintf("API error [%s]: %s", e.Code, e.Info)
+	}
 	// Use http.StatusText via the standard library convention. We import
 	// net/http where APIError is constructed (in client.go) so the call
 	// site passes a stable description; here we just format what we have.
@@ -446,3 +475,27 @@ func NewAPIError(statusCode int, body []byte) *APIError {
 		BodySnippet: string(snippet),
 	}
 }
+
+// NewMediaWikiAPIError builds an APIError for a MediaWiki error object.
+// These arrive with HTTP 200; Info is always non-empty so Error() reports
+// the MediaWiki message.
+func NewMediaWikiAPIError(code, info string) *APIError {
+	if code == "" {
+		code = string(APICodeUnknownError)
+	}
+	if info == "" {
+		info = "no details provided"
+	}
+	return &APIError{
+		StatusCode: 200,
+		Code:       ErrorCode(code),
+		Info:       info,
+	}
+}
+
+// IsAPIErrorCode reports whether err wraps a MediaWiki API error with the
+// given code, e.g. IsAPIErrorCode(err, "badtoken").
+func IsAPIErrorCode(err error, code ErrorCode) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Info != "" && apiErr.Code == code
+}
@@ -1,6 +1,7 @@
 package wiki
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -383,3 +384,42 @@ func TestValidateWikiURL(t *testing.T) {
 		})
 	}
 }
+
+func TestResponseAPIError(t *testing.T) {
+	if err := responseAPIError(map[string]interface{}{"query": map[string]interface{}{}}); err != nil {
+		t.Errorf("responseAPIError(success) = %v, want nil", err)
+	}
+
+	err := responseAPIError(map[string]interface{}{
+		"error": map[string]interface{}{"code": "badtoken", "info": "Invalid CSRF token."},
+	})
+	if err == nil || err.Code != "badtoken" || err.Info != "Invalid CSRF token." {
+		t.Fatalf("responseAPIError = %+v, want code badtoken with info", err)
+	}
+	if got := err.Error(); got != "API error [badtoken]: Invalid CSRF token." {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestIsAPIErrorCode(t *testing.T) {
+	badtoken := NewMediaWikiAPIError("badtoken", "Invalid CSRF token.")
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"direct", badtoken, true},
+		{"wrapped", fmt.Errorf("edit failed: %w", badtoken), true},
+		{"other code", NewMediaWikiAPIError("permissiondenied", "badtoken mentioned in info"), false},
+		{"plain error mentioning code", fmt.Errorf("badtoken"), false},
+		{"HTTP-level error", NewAPIError(400, []byte("badtoken")), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAPIErrorCode(tt.err, "badtoken"); got != tt.want {
+				t.Errorf("IsAPIErrorCode(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -277,7 +277,25 @@ func (c *Client) CompareRevisions(ctx context.Context, args CompareRevisionsArgs
 		ToTimestamp:   getString(compare["totimestamp"]),
 	}
 
+	// Without an explicit format, JSON pages get a key-level diff; a line
+	// diff of pretty-printed JSON buries the change in braces and commas.
+	if args.Format == "" {
+		if changes, ok := c.compareJSONRevisions(ctx, result.FromRevID, result.ToRevID); ok {
+			result.Format = "json"
+			result.Diff = renderJSONChanges(changes)
+			result.JSONChanges = changes
+			return result, nil
+		}
+	}
+
+	if args.Format == "markdown" {
+		result.Format = "markdown"
+		result.Diff, result.LinesAdded, result.LinesRemoved = renderDiffMarkdown(result.Diff)
+		return result, nil
+	}
+
 	// Clean up the diff HTML for readability
+	result.Format = "html"
 	if result.Diff != "" {
 		result.Diff = sanitizeHTML(result.Diff)
 	}
@@ -293,6 +311,9 @@ func validateCompareArgs(args CompareRevisionsArgs) error {
 	if args.ToRev == 0 && args.ToTitle == "" {
 		return fmt.Errorf("either to_rev or to_title is required")
 	}
+	if args.Format != "" && args.Format != "html" && args.Format != "markdown" {
+		return fmt.Errorf("format must be 'html' or 'markdown', got '%s'", args.Format)
+	}
 	return nil
 }
 
@@ -0,0 +1,90 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetDeletedRevisions(t *testing.T) {
+	var gotParams map[string]string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotParams = map[string]string{
+			"prop":     r.FormValue("prop"),
+			"titles":   r.FormValue("titles"),
+			"drvlimit": r.FormValue("drvlimit"),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"continue": map[string]interface{}{"drvcontinue": "20260101000000|5"},
+			"query": map[string]interface{}{
+				"pages": map[string]interface{}{
+					"-1": map[string]interface{}{
+						"ns": float64(0), "title": "Old Page", "missing": "",
+						"deletedrevisions": []interface{}{
+							map[string]interface{}{
+								"revid": float64(12), "parentid": float64(11), "user": "Alice",
+								"timestamp": "2026-09-02T10:00:00Z", "size": float64(340), "comment": "fix typo", "minor": "",
+							},
+							map[string]interface{}{
+								"revid": float64(11), "parentid": float64(0), "user": "Bob",
+								"timestamp": "2026-09-01T09:00:00Z", "size": float64(320), "comment": "create",
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetDeletedRevisions(context.Background(), GetDeletedRevisionsArgs{Title: "Old Page", Limit: 5})
+	if err != nil {
+		t.Fatalf("GetDeletedRevisions: %v", err)
+	}
+	if gotParams["prop"] != "deletedrevisions" || gotParams["titles"] != "Old Page" || gotParams["drvlimit"] != "5" {
+		t.Errorf("request params = %v, want prop=deletedrevisions for Old Page with drvlimit 5", gotParams)
+	}
+	if result.Count != 2 || !result.HasMore || result.Title != "Old Page" {
+		t.Fatalf("result = %+v, want 2 revisions with more available", result)
+	}
+	first := result.Revisions[0]
+	if first.RevID != 12 || first.User != "Alice" || first.Timestamp != "2026-09-02T10:00:00Z" || first.Comment != "fix typo" || !first.Minor {
+		t.Errorf("first revision = %+v", first)
+	}
+	if second := result.Revisions[1]; second.User != "Bob" || second.Comment != "create" || second.Minor {
+		t.Errorf("second revision = %+v", second)
+	}
+
+	if _, err := client.GetDeletedRevisions(context.Background(), GetDeletedRevisionsArgs{}); err == nil {
+		t.Error("expected an error for an empty title")
+	}
+}
+
+func TestGetDeletedRevisions_PermissionDenied(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"error": map[string]interface{}{
+				"code": "permissiondenied",
+				"info": "You don't have permission to view deleted revision information.",
+			},
+		})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.GetDeletedRevisions(context.Background(), GetDeletedRevisionsArgs{Title: "Old Page"})
+	var wikiErr *WikiError
+	if !errors.As(err, &wikiErr) {
+		t.Fatalf("err = %v (%T), want *WikiError", err, err)
+	}
+	if wikiErr.Code != string(AuthCodePermissionDenied) || wikiErr.Input != "deletedhistory" {
+		t.Errorf("err = %+v, want a permission error naming deletedhistory", wikiErr)
+	}
+}
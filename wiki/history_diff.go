@@ -0,0 +1,87 @@
+package wiki
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// diffRowRegex matches one row of a MediaWiki table diff.
+	diffRowRegex = regexp.MustCompile(`(?is)<tr[^>]*>(.*?)</tr>`)
+	// diffCellRegex matches a cell of a diff row, capturing its class list
+	// and inner HTML.
+	diffCellRegex = regexp.MustCompile(`(?is)<td[^>]*class="([^"]*)"[^>]*>(.*?)</td>`)
+	// diffLineNoRegex extracts the line number from a "Line N:" header cell.
+	diffLineNoRegex = regexp.MustCompile(`\d+`)
+)
+
+// renderDiffMarkdown converts MediaWiki's table diff HTML into a Markdown
+// fenced diff block with -/+ lines and a one-line change summary. It also
+// returns the number of added and removed lines.
+func renderDiffMarkdown(diffHTML string) (markdown string, added, removed int) {
+	lines := diffHTMLToUnified(diffHTML)
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			removed++
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%d line(s) added, %d line(s) removed**\n\n", added, removed)
+	b.WriteString("```diff\n")
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	b.WriteString("```\n")
+	return b.String(), added, removed
+}
+
+// diffHTMLToUnified walks the rows of a table diff and returns unified-diff
+// lines: "@@ Line N @@" hunk headers, " " context, "-" removed and "+" added.
+func diffHTMLToUnified(diffHTML string) []string {
+	var lines []string
+	for _, row := range diffRowRegex.FindAllStringSubmatch(diffHTML, -1) {
+		var removed, added, context []string
+		lineNo := ""
+		for _, cell := range diffCellRegex.FindAllStringSubmatch(row[1], -1) {
+			class, text := cell[1], diffCellText(cell[2])
+			switch {
+			case strings.Contains(class, "diff-lineno"):
+				if lineNo == "" {
+					lineNo = diffLineNoRegex.FindString(text)
+				}
+			case strings.Contains(class, "diff-deletedline"):
+				removed = append(removed, "-"+text)
+			case strings.Contains(class, "diff-addedline"):
+				added = append(added, "+"+text)
+			case strings.Contains(class, "diff-context"):
+				// Context appears on both sides of the row; keep one copy.
+				if len(context) == 0 {
+					context = append(context, " "+text)
+				}
+			}
+		}
+		if lineNo != "" {
+			lines = append(lines, fmt.Sprintf("@@ Line %s @@", lineNo))
+		}
+		lines = append(lines, context...)
+		lines = append(lines, removed...)
+		lines = append(lines, added...)
+	}
+	return lines
+}
+
+// diffCellText returns the plain text of a diff cell, dropping the diff's
+// own markup (<div> wrappers, <ins>/<del> highlights) before decoding
+// entities, so escaped wikitext such as &lt;ref&gt; survives as text.
+// Leading whitespace is kept: indentation is part of the changed line.
+func diffCellText(cellHTML string) string {
+	text := html.UnescapeString(htmlTagRegex.ReplaceAllString(cellHTML, ""))
+	return strings.ReplaceAll(text, "\n", " ")
+}
@@ -0,0 +1,64 @@
+package wiki
+
+import "testing"
+
+// sampleTableDiff is a trimmed MediaWiki action=compare table diff: a hunk
+// header, one context line, one changed line with inline highlights, and one
+// added line.
+const sampleTableDiff = `<tr><td colspan="2" class="diff-lineno">Line 3:</td>
+<td colspan="2" class="diff-lineno">Line 3:</td></tr>
+<tr><td class="diff-marker"></td><td class="diff-context diff-side-deleted"><div>== Install ==</div></td>
+<td class="diff-marker"></td><td class="diff-context diff-side-added"><div>== Install ==</div></td></tr>
+<tr><td class="diff-marker" data-marker="−"></td><td class="diff-deletedline diff-side-deleted"><div>Run <del class="diffchange diffchange-inline">setup.exe</del> &amp; wait.</div></td>
+<td class="diff-marker" data-marker="+"></td><td class="diff-addedline diff-side-added"><div>Run <ins class="diffchange diffchange-inline">install.sh</ins> &amp; wait.</div></td></tr>
+<tr><td colspan="2" class="diff-empty diff-side-deleted"></td>
+<td class="diff-marker" data-marker="+"></td><td class="diff-addedline diff-side-added"><div>Then reboot.</div></td></tr>`
+
+const wantMarkdownDiff = "**2 line(s) added, 1 line(s) removed**\n" +
+	"\n" +
+	"```diff\n" +
+	"@@ Line 3 @@\n" +
+	" == Install ==\n" +
+	"-Run setup.exe & wait.\n" +
+	"+Run install.sh & wait.\n" +
+	"+Then reboot.\n" +
+	"```\n"
+
+func TestRenderDiffMarkdown_Golden(t *testing.T) {
+	got, added, removed := renderDiffMarkdown(sampleTableDiff)
+	if got != wantMarkdownDiff {
+		t.Errorf("renderDiffMarkdown mismatch\n--- got ---\n%s\n--- want ---\n%s", got, wantMarkdownDiff)
+	}
+	if added != 2 || removed != 1 {
+		t.Errorf("added=%d removed=%d, want 2 and 1", added, removed)
+	}
+}
+
+// escapedMarkupDiff changes a line holding escaped wikitext markup and an
+// indented line, both of which must come through as written.
+const escapedMarkupDiff = `<tr><td class="diff-marker" data-marker="−"></td><td class="diff-deletedline diff-side-deleted"><div>Fact.&lt;ref&gt;<del class="diffchange diffchange-inline">Old</del> source&lt;/ref&gt;&lt;br /&gt;</div></td>
+<td class="diff-marker" data-marker="+"></td><td class="diff-addedline diff-side-added"><div>Fact.&lt;ref&gt;<ins class="diffchange diffchange-inline">New</ins> source&lt;/ref&gt;&lt;br /&gt;</div></td></tr>
+<tr><td colspan="2" class="diff-empty diff-side-deleted"></td>
+<td class="diff-marker" data-marker="+"></td><td class="diff-addedline diff-side-added"><div>  &lt;syntaxhighlight lang="go"&gt;</div></td></tr>`
+
+const wantEscapedMarkupDiff = "**2 line(s) added, 1 line(s) removed**\n" +
+	"\n" +
+	"```diff\n" +
+	"-Fact.<ref>Old source</ref><br />\n" +
+	"+Fact.<ref>New source</ref><br />\n" +
+	"+  <syntaxhighlight lang=\"go\">\n" +
+	"```\n"
+
+func TestRenderDiffMarkdown_EscapedMarkup(t *testing.T) {
+	got, _, _ := renderDiffMarkdown(escapedMarkupDiff)
+	if got != wantEscapedMarkupDiff {
+		t.Errorf("renderDiffMarkdown mismatch\n--- got ---\n%s\n--- want ---\n%s", got, wantEscapedMarkupDiff)
+	}
+}
+
+func TestRenderDiffMarkdown_Empty(t *testing.T) {
+	got, added, removed := renderDiffMarkdown("")
+	if got != "**0 line(s) added, 0 line(s) removed**\n\n```diff\n```\n" || added != 0 || removed != 0 {
+		t.Errorf("unexpected output for empty diff: %q", got)
+	}
+}
@@ -0,0 +1,234 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// maxEditWarCandidates caps how many busy pages DetectEditWars inspects
+// when scanning recent changes; each costs one history request.
+const maxEditWarCandidates = 20
+
+// editWarRevision is the slice of a revision that revert detection needs.
+type editWarRevision struct {
+	revID     int
+	user      string
+	timestamp string
+	size      int
+	sha1      string
+}
+
+// DetectEditWars flags pages where edits are repeatedly undone in a short
+// window. A revision counts as a revert when it restores the exact content
+// of an earlier revision (same SHA-1), or when it exactly cancels the size
+// change of the previous revision by a different user. A page is reported
+// once it has at least MinReverts reverts by two or more users since Since.
+// With Title set only that page is checked; otherwise the busiest pages in
+// recent changes are.
+func (c *Client) DetectEditWars(ctx context.Context, args DetectEditWarsArgs) (DetectEditWarsResult, error) {
+	since := time.Now().UTC().Add(-24 * time.Hour)
+	if args.Since != "" {
+		t, err := time.Parse(time.RFC3339, args.Since)
+		if err != nil {
+			return DetectEditWarsResult{}, &ValidationError{
+				Field:      "since",
+				Value:      args.Since,
+				Message:    "since must be an ISO 8601 timestamp",
+				Suggestion: `Use a full timestamp such as "2026-10-01T00:00:00Z".`,
+			}
+		}
+		since = t.UTC()
+	}
+	minReverts := args.MinReverts
+	if minReverts <= 0 {
+		minReverts = 3
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return DetectEditWarsResult{}, err
+	}
+
+	result := DetectEditWarsResult{
+		Since:      since.Format(time.RFC3339),
+		MinReverts: minReverts,
+		EditWars:   make([]EditWar, 0),
+	}
+
+	candidates := []string{args.Title}
+	if args.Title == "" {
+		var err error
+		candidates, err = c.busyPagesSince(ctx, since, minReverts+1, args.Namespace)
+		if err != nil {
+			return DetectEditWarsResult{}, err
+		}
+	}
+
+	for _, title := range candidates {
+		revisions, err := c.revisionsSince(ctx, title, since)
+		if err != nil {
+			return result, fmt.Errorf("failed to get history of '%s': %w", title, err)
+		}
+		result.PagesScanned++
+		if war, ok := detectEditWar(title, revisions, minReverts); ok {
+			for _, rev := range revisions {
+				if ts, _ := time.Parse(time.RFC3339, rev.timestamp); !ts.Before(since) {
+					war.Edits++
+				}
+			}
+			result.EditWars = append(result.EditWars, war)
+		}
+	}
+
+	sort.SliceStable(result.EditWars, func(i, j int) bool {
+		return result.EditWars[i].Reverts > result.EditWars[j].Reverts
+	})
+	result.Count = len(result.EditWars)
+	return result, nil
+}
+
+// busyPagesSince returns the titles edited at least minEdits times since
+// the given time, busiest first, capped at maxEditWarCandidates. A negative
+// namespace means all namespaces.
+func (c *Client) busyPagesSince(ctx context.Context, since time.Time, minEdits, namespace int) ([]string, error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("list", "recentchanges")
+	params.Set("rctype", "edit")
+	params.Set("rcprop", "title|timestamp")
+	params.Set("rclimit", strconv.Itoa(MaxLimit))
+	params.Set("rcend", since.Format(time.RFC3339))
+	if namespace >= 0 {
+		params.Set("rcnamespace", strconv.Itoa(namespace))
+	}
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := resp["query"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected API response: missing 'query' object")
+	}
+
+	counts := make(map[string]int)
+	for _, rc := range getSlice(query["recentchanges"]) {
+		if title := getString(getMap(rc)["title"]); title != "" {
+			counts[title]++
+		}
+	}
+	titles := make([]string, 0, len(counts))
+	for title, n := range counts {
+		if n >= minEdits {
+			titles = append(titles, title)
+		}
+	}
+	sort.Slice(titles, func(i, j int) bool {
+		if counts[titles[i]] != counts[titles[j]] {
+			return counts[titles[i]] > counts[titles[j]]
+		}
+		return titles[i] < titles[j]
+	})
+	if len(titles) > maxEditWarCandidates {
+		titles = titles[:maxEditWarCandidates]
+	}
+	return titles, nil
+}
+
+// revisionsSince returns the page's revisions made since the given time,
+// oldest first. The revision just before the window is included too, so
+// the first edit inside it has something to be compared against.
+func (c *Client) revisionsSince(ctx context.Context, title string, since time.Time) ([]editWarRevision, error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("titles", title)
+	params.Set("prop", "revisions")
+	params.Set("rvprop", "ids|user|timestamp|size|sha1")
+	params.Set("rvlimit", "100")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := resp["query"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response format")
+	}
+
+	var revisions []editWarRevision
+	for _, pageData := range getMap(query["pages"]) {
+		for _, raw := range getSlice(getMap(pageData)["revisions"]) {
+			rev := getMap(raw)
+			revisions = append(revisions, editWarRevision{
+				revID:     getInt(rev["revid"]),
+				user:      getString(rev["user"]),
+				timestamp: getString(rev["timestamp"]),
+				size:      getInt(rev["size"]),
+				sha1:      getString(rev["sha1"]),
+			})
+			ts, _ := time.Parse(time.RFC3339, getString(rev["timestamp"]))
+			if ts.Before(since) {
+				break // Newest first: this is the baseline before the window
+			}
+		}
+		break // Only one title is queried
+	}
+
+	for i, j := 0, len(revisions)-1; i < j; i, j = i+1, j-1 {
+		revisions[i], revisions[j] = revisions[j], revisions[i]
+	}
+	return revisions, nil
+}
+
+// detectEditWar counts the reverts in revisions (oldest first) and reports
+// an edit war when there are at least minReverts of them between two or
+// more users.
+func detectEditWar(title string, revisions []editWarRevision, minReverts int) (EditWar, bool) {
+	war := EditWar{Title: title}
+	participants := make(map[string]bool)
+	lastSeen := make(map[string]int) // sha1 -> index of its latest revision
+
+	for i, rev := range revisions {
+		if i > 0 && rev.user != revisions[i-1].user && isRevert(revisions, i, lastSeen) {
+			war.Reverts++
+			war.RevertRevisions = append(war.RevertRevisions, rev.revID)
+			participants[rev.user] = true
+			participants[revisions[i-1].user] = true
+			if war.FirstRevert == "" {
+				war.FirstRevert = rev.timestamp
+			}
+			war.LastRevert = rev.timestamp
+		}
+		if rev.sha1 != "" {
+			lastSeen[rev.sha1] = i
+		}
+	}
+
+	if war.Reverts < minReverts || len(participants) < 2 {
+		return EditWar{}, false
+	}
+	for user := range participants {
+		war.Participants = append(war.Participants, user)
+	}
+	sort.Strings(war.Participants)
+	return war, true
+}
+
+// isRevert reports whether revision i undoes earlier work: it restores the
+// content of a revision before its parent, or its size change exactly
+// cancels its parent's.
+func isRevert(revisions []editWarRevision, i int, lastSeen map[string]int) bool {
+	rev := revisions[i]
+	if j, ok := lastSeen[rev.sha1]; ok && rev.sha1 != "" && j < i-1 {
+		return true
+	}
+	if i < 2 {
+		return false
+	}
+	delta := rev.size - revisions[i-1].size
+	parentDelta := revisions[i-1].size - revisions[i-2].size
+	return delta != 0 && delta == -parentDelta
+}
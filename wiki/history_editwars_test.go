@@ -0,0 +1,86 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDetectEditWars(t *testing.T) {
+	// Newest first, as the API returns them
+	histories := map[string][]interface{}{
+		"Contested": {
+			editWarRev(106, "Carol", "2026-10-01T15:00:00Z", 130, "c"),
+			editWarRev(105, "Alice", "2026-10-01T14:00:00Z", 100, "a"),
+			editWarRev(104, "Bob", "2026-10-01T13:00:00Z", 120, "b"),
+			editWarRev(103, "Alice", "2026-10-01T12:00:00Z", 100, "a"),
+			editWarRev(102, "Bob", "2026-10-01T11:00:00Z", 120, "b"),
+			editWarRev(101, "Alice", "2026-09-30T10:00:00Z", 100, "a"),
+			editWarRev(100, "Alice", "2026-09-01T10:00:00Z", 50, "z"),
+		},
+		"Calm": {
+			editWarRev(204, "Dan", "2026-10-01T14:00:00Z", 400, "w"),
+			editWarRev(203, "Eve", "2026-10-01T13:00:00Z", 300, "x"),
+			editWarRev(202, "Dan", "2026-10-01T12:00:00Z", 200, "y"),
+			editWarRev(201, "Eve", "2026-10-01T11:00:00Z", 100, "v"),
+		},
+	}
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("list") == "recentchanges" {
+			if r.FormValue("rcend") != "2026-10-01T00:00:00Z" {
+				t.Errorf("rcend = %q, want the since timestamp", r.FormValue("rcend"))
+			}
+			var changes []interface{}
+			for title, count := range map[string]int{"Contested": 5, "Calm": 4, "Quiet": 1} {
+				for i := 0; i < count; i++ {
+					changes = append(changes, map[string]interface{}{"title": title})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"query": map[string]interface{}{"recentchanges": changes}})
+			return
+		}
+		title := r.FormValue("titles")
+		if _, ok := histories[title]; !ok {
+			t.Errorf("history requested for %q, which is not busy enough", title)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"query": map[string]interface{}{"pages": map[string]interface{}{
+			"1": map[string]interface{}{"title": title, "revisions": histories[title]},
+		}}})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.DetectEditWars(context.Background(), DetectEditWarsArgs{Since: "2026-10-01T00:00:00Z"})
+	if err != nil {
+		t.Fatalf("DetectEditWars: %v", err)
+	}
+	if result.PagesScanned != 2 || result.Count != 1 {
+		t.Fatalf("result = %+v, want 2 pages scanned and 1 edit war", result)
+	}
+	want := EditWar{
+		Title:           "Contested",
+		Edits:           5,
+		Reverts:         3,
+		Participants:    []string{"Alice", "Bob"},
+		FirstRevert:     "2026-10-01T12:00:00Z",
+		LastRevert:      "2026-10-01T14:00:00Z",
+		RevertRevisions: []int{103, 104, 105},
+	}
+	if !reflect.DeepEqual(result.EditWars[0], want) {
+		t.Errorf("edit war = %+v, want %+v", result.EditWars[0], want)
+	}
+
+	if _, err := client.DetectEditWars(context.Background(), DetectEditWarsArgs{Since: "yesterday"}); err == nil {
+		t.Error("expected an error for a malformed since")
+	}
+}
+
+func editWarRev(revid int, user, timestamp string, size int, sha1 string) map[string]interface{} {
+	return map[string]interface{}{
+		"revid": float64(revid), "user": user, "timestamp": timestamp, "size": float64(size), "sha1": sha1,
+	}
+}
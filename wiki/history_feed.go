@@ -0,0 +1,182 @@
+package wiki
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// atomFeed is the root element of an Atom 1.0 document.
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string     `xml:"title"`
+	ID      string     `xml:"id"`
+	Updated string     `xml:"updated"`
+	Link    atomLink   `xml:"link"`
+	Author  atomAuthor `xml:"author"`
+	Summary string     `xml:"summary,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// rssFeed is the root element of an RSS 2.0 document.
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description,omitempty"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+// GetRecentChangesFeed renders recent changes as an Atom (default) or RSS
+// 2.0 document. Entry links are built from the wiki's ArticlePath.
+func (c *Client) GetRecentChangesFeed(ctx context.Context, args RecentChangesFeedArgs) (RecentChangesFeedResult, error) {
+	format := strings.ToLower(args.Format)
+	if format == "" {
+		format = "atom"
+	}
+	if format != "atom" && format != "rss" {
+		return RecentChangesFeedResult{}, &ValidationError{
+			Field:   "format",
+			Value:   args.Format,
+			Message: "format must be 'atom' or 'rss'",
+		}
+	}
+
+	changes, err := c.GetRecentChanges(ctx, RecentChangesArgs{
+		Limit:     args.Limit,
+		Namespace: args.Namespace,
+	})
+	if err != nil {
+		return RecentChangesFeedResult{}, err
+	}
+
+	feedTitle := "Recent changes"
+	if info, err := c.GetWikiInfo(ctx, WikiInfoArgs{}); err == nil && info.SiteName != "" {
+		feedTitle = info.SiteName + " - Recent changes"
+	}
+	feedURL := c.pageURL(ctx, "Special:RecentChanges")
+
+	var doc interface{}
+	contentType := "application/atom+xml"
+	if format == "rss" {
+		doc = c.buildRSSFeed(ctx, feedTitle, feedURL, changes.Changes)
+		contentType = "application/rss+xml"
+	} else {
+		doc = c.buildAtomFeed(ctx, feedTitle, feedURL, changes.Changes)
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return RecentChangesFeedResult{}, fmt.Errorf("failed to encode feed: %w", err)
+	}
+
+	return RecentChangesFeedResult{
+		Format:      format,
+		ContentType: contentType,
+		Entries:     len(changes.Changes),
+		XML:         xml.Header + string(out),
+	}, nil
+}
+
+// buildAtomFeed maps recent changes to an Atom feed.
+func (c *Client) buildAtomFeed(ctx context.Context, title, feedURL string, changes []RecentChange) atomFeed {
+	updated := time.Now().UTC()
+	if len(changes) > 0 && !changes[0].Timestamp.IsZero() {
+		updated = changes[0].Timestamp.UTC()
+	}
+	feed := atomFeed{
+		Title:   title,
+		ID:      feedURL,
+		Updated: updated.Format(time.RFC3339),
+		Link:    atomLink{Href: feedURL, Rel: "self"},
+		Entries: make([]atomEntry, 0, len(changes)),
+	}
+	for _, change := range changes {
+		link := c.pageURL(ctx, change.Title)
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   change.Title,
+			ID:      feedEntryID(link, change),
+			Updated: change.Timestamp.UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: link},
+			Author:  atomAuthor{Name: change.User},
+			Summary: feedEntrySummary(change),
+		})
+	}
+	return feed
+}
+
+// buildRSSFeed maps recent changes to an RSS 2.0 feed.
+func (c *Client) buildRSSFeed(ctx context.Context, title, feedURL string, changes []RecentChange) rssFeed {
+	channel := rssChannel{
+		Title:       title,
+		Link:        feedURL,
+		Description: title,
+		Items:       make([]rssItem, 0, len(changes)),
+	}
+	for _, change := range changes {
+		link := c.pageURL(ctx, change.Title)
+		channel.Items = append(channel.Items, rssItem{
+			Title:       change.Title,
+			Link:        link,
+			GUID:        rssGUID{Value: feedEntryID(link, change)},
+			PubDate:     change.Timestamp.UTC().Format(time.RFC1123Z),
+			Description: feedEntrySummary(change),
+		})
+	}
+	return rssFeed{Version: "2.0", Channel: channel}
+}
+
+// feedEntryID returns a stable, unique ID for a change: the page link plus
+// the revision, so several edits to one page stay distinct.
+func feedEntryID(link string, change RecentChange) string {
+	if change.RevisionID == 0 {
+		return fmt.Sprintf("%s#%s", link, change.Timestamp.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%s#rev%d", link, change.RevisionID)
+}
+
+// feedEntrySummary describes a change in one line: type, author, size
+// delta, and edit summary.
+func feedEntrySummary(change RecentChange) string {
+	summary := fmt.Sprintf("%s by %s (%+d bytes)", change.Type, change.User, change.SizeDiff)
+	if change.Comment != "" {
+		summary += ": " + change.Comment
+	}
+	return summary
+}
@@ -0,0 +1,129 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// recentChangesFeedServer serves two recent changes and siteinfo with a
+// pretty article path.
+func recentChangesFeedServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("list") == "recentchanges":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"recentchanges": []interface{}{
+						map[string]interface{}{
+							"type": "edit", "title": "Release Notes", "pageid": float64(1), "revid": float64(42),
+							"user": "Alice", "timestamp": "2024-05-02T10:00:00Z", "comment": "Add 2.1",
+							"oldlen": float64(100), "newlen": float64(150),
+						},
+						map[string]interface{}{
+							"type": "new", "title": "User:Bob/Sandbox", "pageid": float64(2), "revid": float64(41),
+							"user": "Bob", "timestamp": "2024-05-01T09:00:00Z", "newlen": float64(20),
+						},
+					},
+				},
+			})
+		case r.FormValue("meta") == "siteinfo":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"general": map[string]interface{}{
+						"sitename":    "Docs Wiki",
+						"server":      "https://wiki.example.com",
+						"articlepath": "/wiki/$1",
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func TestGetRecentChangesFeed_Atom(t *testing.T) {
+	server := recentChangesFeedServer(t)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetRecentChangesFeed(context.Background(), RecentChangesFeedArgs{Namespace: -1})
+	if err != nil {
+		t.Fatalf("GetRecentChangesFeed failed: %v", err)
+	}
+	if result.Format != "atom" || result.ContentType != "application/atom+xml" || result.Entries != 2 {
+		t.Errorf("result = %+v", result)
+	}
+
+	var feed atomFeed
+	if err := xml.Unmarshal([]byte(result.XML), &feed); err != nil {
+		t.Fatalf("feed is not well-formed XML: %v", err)
+	}
+	if feed.Title != "Docs Wiki - Recent changes" {
+		t.Errorf("feed title = %q", feed.Title)
+	}
+	if len(feed.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(feed.Entries))
+	}
+	if got := feed.Entries[0].Link.Href; got != "https://wiki.example.com/wiki/Release_Notes" {
+		t.Errorf("entry[0] link = %q", got)
+	}
+	if got := feed.Entries[1].Link.Href; got != "https://wiki.example.com/wiki/User:Bob/Sandbox" {
+		t.Errorf("entry[1] link = %q", got)
+	}
+	if feed.Entries[0].ID == feed.Entries[1].ID {
+		t.Error("entry IDs must be unique")
+	}
+	if feed.Entries[0].Summary != "edit by Alice (+50 bytes): Add 2.1" {
+		t.Errorf("entry[0] summary = %q", feed.Entries[0].Summary)
+	}
+}
+
+func TestGetRecentChangesFeed_RSS(t *testing.T) {
+	server := recentChangesFeedServer(t)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetRecentChangesFeed(context.Background(), RecentChangesFeedArgs{Namespace: -1, Format: "RSS"})
+	if err != nil {
+		t.Fatalf("GetRecentChangesFeed failed: %v", err)
+	}
+
+	var feed rssFeed
+	if err := xml.Unmarshal([]byte(result.XML), &feed); err != nil {
+		t.Fatalf("feed is not well-formed XML: %v", err)
+	}
+	if feed.Version != "2.0" || len(feed.Channel.Items) != 2 {
+		t.Fatalf("feed = %+v", feed)
+	}
+	item := feed.Channel.Items[0]
+	if item.Link != "https://wiki.example.com/wiki/Release_Notes" {
+		t.Errorf("item link = %q", item.Link)
+	}
+	if item.PubDate != "Thu, 02 May 2024 10:00:00 +0000" {
+		t.Errorf("pubDate = %q", item.PubDate)
+	}
+	if feed.Channel.Link != "https://wiki.example.com/wiki/Special:RecentChanges" {
+		t.Errorf("channel link = %q", feed.Channel.Link)
+	}
+}
+
+func TestGetRecentChangesFeed_InvalidFormat(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	_, err := client.GetRecentChangesFeed(context.Background(), RecentChangesFeedArgs{Format: "json"})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,190 @@
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// compareJSONRevisions returns the key-level changes between two revisions
+// of a JSON page. Only the content models are fetched first; the content of
+// both revisions is pulled only when both are "json", so ordinary page
+// diffs cost one small query. ok is false when either side is not JSON or
+// could not be fetched, and the caller falls back to MediaWiki's text diff.
+func (c *Client) compareJSONRevisions(ctx context.Context, fromRev, toRev int) (changes []JSONChange, ok bool) {
+	if fromRev <= 0 || toRev <= 0 {
+		return nil, false
+	}
+
+	models, err := c.fetchRevisionSlots(ctx, fromRev, toRev, "ids|contentmodel")
+	if err != nil {
+		c.logger.Debug("JSON diff skipped: content model unavailable", "error", err)
+		return nil, false
+	}
+	if getString(models[fromRev]["contentmodel"]) != "json" || getString(models[toRev]["contentmodel"]) != "json" {
+		return nil, false
+	}
+
+	slots, err := c.fetchRevisionSlots(ctx, fromRev, toRev, "ids|content")
+	if err != nil {
+		c.logger.Debug("JSON diff skipped: revision content unavailable", "error", err)
+		return nil, false
+	}
+	fromValue, fromErr := parseJSONDocument(slotContent(slots[fromRev]))
+	toValue, toErr := parseJSONDocument(slotContent(slots[toRev]))
+	if fromErr != nil || toErr != nil {
+		return nil, false
+	}
+
+	changes = make([]JSONChange, 0)
+	diffJSONValues("", fromValue, toValue, &changes)
+	return changes, true
+}
+
+// fetchRevisionSlots returns the main slot of each of the two revisions,
+// keyed by revision ID, with the given rvprop fields.
+func (c *Client) fetchRevisionSlots(ctx context.Context, fromRev, toRev int, rvprop string) (map[int]map[string]interface{}, error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("prop", "revisions")
+	params.Set("revids", strconv.Itoa(fromRev)+"|"+strconv.Itoa(toRev))
+	params.Set("rvprop", rvprop)
+	params.Set("rvslots", "main")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	slots := make(map[int]map[string]interface{}, 2)
+	for _, pageData := range getMap(getMap(resp["query"])["pages"]) {
+		for _, raw := range getSlice(getMap(pageData)["revisions"]) {
+			rev := getMap(raw)
+			slots[getInt(rev["revid"])] = getMap(getMap(rev["slots"])["main"])
+		}
+	}
+	return slots, nil
+}
+
+// slotContent returns a slot's content in either response format.
+func slotContent(slot map[string]interface{}) string {
+	if content := getString(slot["*"]); content != "" {
+		return content
+	}
+	return getString(slot["content"])
+}
+
+// parseJSONDocument decodes content as a single JSON value, keeping numbers
+// in their original spelling so large integers survive the round trip.
+func parseJSONDocument(content string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(content))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("trailing data after JSON value")
+	}
+	return value, nil
+}
+
+// diffJSONValues appends the changes that turn from into to, recursing into
+// objects (by key, in sorted order) and arrays (by index).
+func diffJSONValues(path string, from, to interface{}, changes *[]JSONChange) {
+	switch f := from.(type) {
+	case map[string]interface{}:
+		t, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(f)+len(t))
+		for k := range f {
+			keys = append(keys, k)
+		}
+		for k := range t {
+			if _, seen := f[k]; !seen {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+			fv, inFrom := f[k]
+			tv, inTo := t[k]
+			switch {
+			case !inFrom:
+				*changes = append(*changes, JSONChange{Path: childPath, Change: "added", New: tv})
+			case !inTo:
+				*changes = append(*changes, JSONChange{Path: childPath, Change: "removed", Old: fv})
+			default:
+				diffJSONValues(childPath, fv, tv, changes)
+			}
+		}
+		return
+	case []interface{}:
+		t, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < max(len(f), len(t)); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(f):
+				*changes = append(*changes, JSONChange{Path: childPath, Change: "added", New: t[i]})
+			case i >= len(t):
+				*changes = append(*changes, JSONChange{Path: childPath, Change: "removed", Old: f[i]})
+			default:
+				diffJSONValues(childPath, f[i], t[i], changes)
+			}
+		}
+		return
+	}
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, JSONChange{Path: path, Change: "changed", Old: from, New: to})
+	}
+}
+
+// renderJSONChanges formats changes as a one-line summary and a fenced block
+// with one "+" (added), "-" (removed) or "~" (changed) line per path.
+func renderJSONChanges(changes []JSONChange) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%d JSON change(s)**\n\n", len(changes))
+	b.WriteString("```diff\n")
+	for _, ch := range changes {
+		path := ch.Path
+		if path == "" {
+			path = "(root)"
+		}
+		switch ch.Change {
+		case "added":
+			fmt.Fprintf(&b, "+ %s: %s\n", path, compactJSON(ch.New))
+		case "removed":
+			fmt.Fprintf(&b, "- %s: %s\n", path, compactJSON(ch.Old))
+		default:
+			fmt.Fprintf(&b, "~ %s: %s → %s\n", path, compactJSON(ch.Old), compactJSON(ch.New))
+		}
+	}
+	b.WriteString("```\n")
+	return b.String()
+}
+
+// compactJSON renders v on one line without HTML escaping.
+func compactJSON(v interface{}) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
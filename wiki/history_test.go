@@ -7,6 +7,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -474,6 +477,99 @@ func TestCompareRevisions_Success(t *testing.T) {
 	}
 }
 
+func TestCompareRevisions_JSONContent(t *testing.T) {
+	contents := map[float64]string{
+		455: `{"name": "Widget", "settings": {"theme": {"color": "red", "size": 12}, "tags": ["a"]}, "legacy": true}`,
+		456: `{"name": "Widget", "settings": {"theme": {"color": "blue", "size": 12}, "tags": ["a", "b"]}, "owner": "ops"}`,
+	}
+	model := "json"
+	var contentFetches int
+	server := createHistoryMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") == "compare" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"compare": map[string]interface{}{
+					"fromtitle": "Config.json", "fromrevid": float64(455),
+					"totitle": "Config.json", "torevid": float64(456),
+					"*": "<tr><td class=\"diff-deletedline\">red</td><td class=\"diff-addedline\">blue</td></tr>",
+				},
+			})
+			return
+		}
+		rvprop := strings.Split(r.FormValue("rvprop"), "|")
+		withContent := slices.Contains(rvprop, "content")
+		if withContent {
+			contentFetches++
+		}
+		revisions := make([]interface{}, 0, len(contents))
+		for _, revid := range []float64{455, 456} {
+			main := map[string]interface{}{}
+			if slices.Contains(rvprop, "contentmodel") {
+				main["contentmodel"] = model
+			}
+			if withContent {
+				main["*"] = contents[revid]
+			}
+			revisions = append(revisions, map[string]interface{}{
+				"revid": revid,
+				"slots": map[string]interface{}{"main": main},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{"pages": map[string]interface{}{
+				"7": map[string]interface{}{"pageid": float64(7), "title": "Config.json", "revisions": revisions},
+			}},
+		})
+	})
+	defer server.Close()
+	client := createHistoryTestClient(t, server)
+	defer client.Close()
+
+	result, err := client.CompareRevisions(context.Background(), CompareRevisionsArgs{FromRev: 455, ToRev: 456})
+	if err != nil {
+		t.Fatalf("CompareRevisions: %v", err)
+	}
+	if result.Format != "json" {
+		t.Fatalf("Format = %q, want json", result.Format)
+	}
+	want := []JSONChange{
+		{Path: "legacy", Change: "removed", Old: true},
+		{Path: "owner", Change: "added", New: "ops"},
+		{Path: "settings.tags[1]", Change: "added", New: "b"},
+		{Path: "settings.theme.color", Change: "changed", Old: "red", New: "blue"},
+	}
+	if !reflect.DeepEqual(result.JSONChanges, want) {
+		t.Errorf("JSONChanges = %+v, want %+v", result.JSONChanges, want)
+	}
+	if !strings.Contains(result.Diff, `~ settings.theme.color: "red" → "blue"`) {
+		t.Errorf("Diff = %q, want the nested change rendered", result.Diff)
+	}
+
+	// An explicit format keeps the text diff.
+	result, err = client.CompareRevisions(context.Background(), CompareRevisionsArgs{FromRev: 455, ToRev: 456, Format: "markdown"})
+	if err != nil || result.Format != "markdown" || result.JSONChanges != nil {
+		t.Errorf("markdown compare = %+v, %v; want a text diff", result, err)
+	}
+
+	// Content that does not parse falls back to the HTML diff.
+	contents[456] = "{ not JSON"
+	result, err = client.CompareRevisions(context.Background(), CompareRevisionsArgs{FromRev: 455, ToRev: 456})
+	if err != nil || result.Format != "html" {
+		t.Errorf("unparseable compare = %+v, %v; want an HTML diff", result, err)
+	}
+
+	// Other content models get the HTML diff without their content fetched.
+	model = "wikitext"
+	contentFetches = 0
+	result, err = client.CompareRevisions(context.Background(), CompareRevisionsArgs{FromRev: 455, ToRev: 456})
+	if err != nil || result.Format != "html" {
+		t.Errorf("wikitext compare = %+v, %v; want an HTML diff", result, err)
+	}
+	if contentFetches != 0 {
+		t.Errorf("content fetched %d times for a wikitext page, want 0", contentFetches)
+	}
+}
+
 func TestCompareRevisions_MissingFromRev(t *testing.T) {
 	config := &Config{
 		BaseURL:    "https://test.wiki.com/api.php",
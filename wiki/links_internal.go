@@ -53,17 +53,20 @@ func extractInternalLinks(pageTitle, line string, lineNum int) []linkLocation {
 			pageTitle: pageTitle,
 			target:    target,
 			line:      lineNum + 1,
-			context:   extractContext(line, idx, idx+len(match[0]), 30),
+			context:   extractWordContext(line, idx, idx+len(match[0]), 30),
 		})
 	}
 	return out
 }
 
-// collectInternalLinkLocations fetches each page and extracts its internal
-// link locations. Pages that fail to fetch produce error entries in the result;
-// successfully-fetched pages are recorded in fetched so the caller can build
-// per-page result rows for them.
-func (c *Client) collectInternalLinkLocations(ctx context.Context, pages []string) (locations []linkLocation, fetched map[string]struct{}, errResults []PageBrokenLinksResult, err error) {
+// linkExtractor pulls the link locations out of one line of a page.
+type linkExtractor func(pageTitle, line string, lineNum int) []linkLocation
+
+// collectLinkLocations fetches each page and extracts its link locations
+// line by line with extract. Pages that fail to fetch produce error entries
+// in the result; successfully-fetched pages are recorded in fetched so the
+// caller can build per-page result rows for them.
+func (c *Client) collectLinkLocations(ctx context.Context, pages []string, extract linkExtractor) (locations []linkLocation, fetched map[string]struct{}, errResults []PageBrokenLinksResult, err error) {
 	fetched = make(map[string]struct{}, len(pages))
 	for _, pageTitle := range pages {
 		select {
@@ -82,7 +85,7 @@ func (c *Client) collectInternalLinkLocations(ctx context.Context, pages []strin
 		}
 		fetched[pageTitle] = struct{}{}
 		for lineNum, line := range strings.Split(page.Content, "\n") {
-			locations = append(locations, extractInternalLinks(pageTitle, line, lineNum)...)
+			locations = append(locations, extract(pageTitle, line, lineNum)...)
 		}
 	}
 	return locations, fetched, errResults, nil
@@ -104,8 +107,9 @@ func uniqueLinkTargets(locations []linkLocation) []string {
 
 // buildBrokenLinksResults turns link locations + existence-map into per-page
 // PageBrokenLinksResult rows. Within each page, only the first occurrence of
-// each broken target is reported.
-func buildBrokenLinksResults(pages []string, fetched map[string]struct{}, locations []linkLocation, existence map[string]bool) []PageBrokenLinksResult {
+// each broken target is reported, and at most maxIssues are listed
+// (maxIssues <= 0 means no cap); BrokenCount still counts them all.
+func buildBrokenLinksResults(pages []string, fetched map[string]struct{}, locations []linkLocation, existence map[string]bool, maxIssues int) []PageBrokenLinksResult {
 	pageResults := make(map[string]*PageBrokenLinksResult, len(fetched))
 	for _, title := range pages {
 		if _, ok := fetched[title]; ok {
@@ -131,19 +135,24 @@ func buildBrokenLinksResults(pages []string, fetched map[string]struct{}, locati
 		seen[loc.pageTitle][loc.target] = true
 
 		exists, ok := existence[loc.target]
-		if !ok || !exists {
-			pr.BrokenLinks = append(pr.BrokenLinks, BrokenLink{
-				Target:  loc.target,
-				Line:    loc.line,
-				Context: loc.context,
-			})
+		if ok && exists {
+			continue
 		}
+		pr.BrokenCount++
+		if maxIssues > 0 && len(pr.BrokenLinks) >= maxIssues {
+			pr.Truncated = true
+			continue
+		}
+		pr.BrokenLinks = append(pr.BrokenLinks, BrokenLink{
+			Target:  loc.target,
+			Line:    loc.line,
+			Context: loc.context,
+		})
 	}
 
 	out := make([]PageBrokenLinksResult, 0, len(pageResults))
 	for _, title := range pages {
 		if pr, ok := pageResults[title]; ok {
-			pr.BrokenCount = len(pr.BrokenLinks)
 			out = append(out, *pr)
 		}
 	}
@@ -160,12 +169,13 @@ func (c *Client) FindBrokenInternalLinks(ctx context.Context, args FindBrokenInt
 	if err != nil {
 		return FindBrokenInternalLinksResult{}, err
 	}
+	maxIssues := normalizeLimit(args.MaxIssuesPerPage, DefaultMaxIssuesPerPage, maxIssuesPerPageLimit)
 
-	locations, fetched, errResults, err := c.collectInternalLinkLocations(ctx, pagesToCheck)
+	locations, fetched, errResults, err := c.collectLinkLocations(ctx, pagesToCheck, extractInternalLinks)
 	if err != nil {
 		// Context cancellation: return what we have so far.
 		return FindBrokenInternalLinksResult{
-			Pages: append(errResults, buildBrokenLinksResults(pagesToCheck, fetched, locations, nil)...),
+			Pages: append(errResults, buildBrokenLinksResults(pagesToCheck, fetched, locations, nil, maxIssues)...),
 		}, err
 	}
 
@@ -174,7 +184,7 @@ func (c *Client) FindBrokenInternalLinks(ctx context.Context, args FindBrokenInt
 		return FindBrokenInternalLinksResult{}, fmt.Errorf("failed to check page existence: %w", err)
 	}
 
-	successResults := buildBrokenLinksResults(pagesToCheck, fetched, locations, existence)
+	successResults := buildBrokenLinksResults(pagesToCheck, fetched, locations, existence, maxIssues)
 
 	result := FindBrokenInternalLinksResult{
 		Pages: make([]PageBrokenLinksResult, 0, len(errResults)+len(successResults)),
@@ -188,13 +198,13 @@ func (c *Client) FindBrokenInternalLinks(ctx context.Context, args FindBrokenInt
 	return result, nil
 }
 
-// FindOrphanedPages finds pages that have no incoming links from other pages
-// queryLonelyPages calls the Lonelypages querypage and returns the raw page entries.
-func (c *Client) queryLonelyPages(ctx context.Context, limit int) ([]interface{}, error) {
+// querySpecialPage calls a querypage special page (Lonelypages, Deadendpages)
+// and returns the raw page entries.
+func (c *Client) querySpecialPage(ctx context.Context, qppage string, limit int) ([]interface{}, error) {
 	params := url.Values{}
 	params.Set("action", "query")
 	params.Set("list", "querypage")
-	params.Set("qppage", "Lonelypages")
+	params.Set("qppage", qppage)
 	params.Set("qplimit", strconv.Itoa(limit))
 
 	resp, err := c.apiRequest(ctx, params)
@@ -216,9 +226,9 @@ func (c *Client) queryLonelyPages(ctx context.Context, limit int) ([]interface{}
 	return results, nil
 }
 
-// orphanedPageMatchesFilter reports whether the page entry passes the namespace
-// and title-prefix filters, returning the page's title when it does.
-func orphanedPageMatchesFilter(entry interface{}, namespace int, prefix string) (string, bool) {
+// querypageEntryMatchesFilter reports whether the page entry passes the
+// namespace and title-prefix filters, returning the page's title when it does.
+func querypageEntryMatchesFilter(entry interface{}, namespace int, prefix string) (string, bool) {
 	page, ok := entry.(map[string]interface{})
 	if !ok {
 		return "", false
@@ -282,28 +292,111 @@ func (c *Client) fetchOrphanedPagesInfo(ctx context.Context, titles []string) []
 	return orphaned
 }
 
+// filterQuerypageEntries returns the titles of the entries that pass the
+// namespace and title-prefix filters.
+func filterQuerypageEntries(results []interface{}, namespace int, prefix string) []string {
+	var titles []string
+	for _, r := range results {
+		if title, ok := querypageEntryMatchesFilter(r, namespace, prefix); ok {
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
+
+// FindOrphanedPages finds pages that have no incoming links from other pages
 func (c *Client) FindOrphanedPages(ctx context.Context, args FindOrphanedPagesArgs) (FindOrphanedPagesResult, error) {
 	if err := c.EnsureLoggedIn(ctx); err != nil {
 		return FindOrphanedPagesResult{}, err
 	}
 
 	limit := normalizeLimit(args.Limit, 50, 200)
-	results, err := c.queryLonelyPages(ctx, limit)
+	results, err := c.querySpecialPage(ctx, "Lonelypages", limit)
 	if err != nil {
 		return FindOrphanedPagesResult{}, err
 	}
 
-	var filteredTitles []string
-	for _, r := range results {
-		if title, ok := orphanedPageMatchesFilter(r, args.Namespace, args.Prefix); ok {
-			filteredTitles = append(filteredTitles, title)
-		}
-	}
-
-	orphaned := c.fetchOrphanedPagesInfo(ctx, filteredTitles)
+	orphaned := c.fetchOrphanedPagesInfo(ctx, filterQuerypageEntries(results, args.Namespace, args.Prefix))
 	return FindOrphanedPagesResult{
 		OrphanedPages: orphaned,
 		TotalChecked:  len(results),
 		OrphanedCount: len(orphaned),
 	}, nil
 }
+
+// FindDeadEndPages finds pages that contain no links to other pages. These
+// are usually stubs that need cross-linking.
+func (c *Client) FindDeadEndPages(ctx context.Context, args FindDeadEndPagesArgs) (FindDeadEndPagesResult, error) {
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return FindDeadEndPagesResult{}, err
+	}
+
+	limit := normalizeLimit(args.Limit, 50, 200)
+	results, err := c.querySpecialPage(ctx, "Deadendpages", limit)
+	if err != nil {
+		return FindDeadEndPagesResult{}, err
+	}
+
+	info := c.fetchOrphanedPagesInfo(ctx, filterQuerypageEntries(results, args.Namespace, args.Prefix))
+	deadEnds := make([]DeadEndPage, 0, len(info))
+	for _, page := range info {
+		deadEnds = append(deadEnds, DeadEndPage(page))
+	}
+	return FindDeadEndPagesResult{
+		DeadEndPages: deadEnds,
+		TotalChecked: len(results),
+		DeadEndCount: len(deadEnds),
+	}, nil
+}
+
+// linkCountEntries converts querypage entries whose value is an incoming-link
+// count (Mostlinked, Wantedpages) into PageLinkCount values, keeping the
+// API's ordering and dropping entries outside namespace (-1 keeps all).
+func linkCountEntries(results []interface{}, namespace int) []PageLinkCount {
+	pages := make([]PageLinkCount, 0, len(results))
+	for _, r := range results {
+		if _, ok := querypageEntryMatchesFilter(r, namespace, ""); !ok {
+			continue
+		}
+		entry := getMap(r)
+		links, err := strconv.Atoi(getString(entry["value"]))
+		if err != nil {
+			links = getInt(entry["value"])
+		}
+		pages = append(pages, PageLinkCount{
+			Title:     getString(entry["title"]),
+			Namespace: getInt(entry["ns"]),
+			Links:     links,
+		})
+	}
+	return pages
+}
+
+// GetMostLinkedPages lists the pages with the most incoming links
+func (c *Client) GetMostLinkedPages(ctx context.Context, args GetMostLinkedPagesArgs) (PageLinkCountsResult, error) {
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return PageLinkCountsResult{}, err
+	}
+
+	results, err := c.querySpecialPage(ctx, "Mostlinked", normalizeLimit(args.Limit, 50, 200))
+	if err != nil {
+		return PageLinkCountsResult{}, err
+	}
+	pages := linkCountEntries(results, args.Namespace)
+	return PageLinkCountsResult{Pages: pages, Count: len(pages)}, nil
+}
+
+// GetWantedPages lists pages that do not exist but are linked to, with the
+// number of links to each. Heavily wanted pages are good candidates to create.
+func (c *Client) GetWantedPages(ctx context.Context, args GetWantedPagesArgs) (PageLinkCountsResult, error) {
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return PageLinkCountsResult{}, err
+	}
+
+	results, err := c.querySpecialPage(ctx, "Wantedpages", normalizeLimit(args.Limit, 50, 200))
+	if err != nil {
+		return PageLinkCountsResult{}, err
+	}
+	pages := linkCountEntries(results, args.Namespace)
+	return PageLinkCountsResult{Pages: pages, Count: len(pages)}, nil
+}
@@ -0,0 +1,78 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// invokeRegex matches a Scribunto "{{#invoke:Module|function}}" call,
+// capturing the module name.
+var invokeRegex = regexp.MustCompile(`(?i)\{\{\s*#invoke\s*:\s*([^|}]+)`)
+
+// extractModuleInvocations pulls the Module: pages invoked on a single line,
+// with the caller-supplied page title and line number stamped onto each.
+func extractModuleInvocations(pageTitle, line string, lineNum int) []linkLocation {
+	var out []linkLocation
+	for _, match := range invokeRegex.FindAllStringSubmatchIndex(line, -1) {
+		name := strings.TrimSpace(line[match[2]:match[3]])
+		if name == "" {
+			continue
+		}
+		// #invoke takes the name without the namespace, but tolerates it
+		if !strings.HasPrefix(strings.ToLower(name), "module:") {
+			name = "Module:" + name
+		}
+		out = append(out, linkLocation{
+			pageTitle: pageTitle,
+			target:    name,
+			line:      lineNum + 1,
+			context:   extractWordContext(line, match[0], match[1], 30),
+		})
+	}
+	return out
+}
+
+// FindMissingModules scans pages for {{#invoke:...}} calls and reports the
+// ones whose Module: page does not exist. Such calls render as a Lua
+// "Script error" on Scribunto wikis. It shares the page collection and
+// existence checks of FindBrokenInternalLinks.
+func (c *Client) FindMissingModules(ctx context.Context, args FindMissingModulesArgs) (FindMissingModulesResult, error) {
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return FindMissingModulesResult{}, err
+	}
+
+	limit := normalizeLimit(args.Limit, 20, 100)
+	pagesToCheck, err := c.collectPagesFromArgs(ctx, args.Pages, args.Category, limit, "pages")
+	if err != nil {
+		return FindMissingModulesResult{}, err
+	}
+	maxIssues := normalizeLimit(args.MaxIssuesPerPage, DefaultMaxIssuesPerPage, maxIssuesPerPageLimit)
+
+	locations, fetched, errResults, err := c.collectLinkLocations(ctx, pagesToCheck, extractModuleInvocations)
+	if err != nil {
+		// Context cancellation: return what we have so far.
+		return FindMissingModulesResult{
+			Pages: append(errResults, buildBrokenLinksResults(pagesToCheck, fetched, locations, nil, maxIssues)...),
+		}, err
+	}
+
+	existence, err := c.checkPagesExist(ctx, uniqueLinkTargets(locations))
+	if err != nil {
+		return FindMissingModulesResult{}, fmt.Errorf("failed to check module existence: %w", err)
+	}
+
+	successResults := buildBrokenLinksResults(pagesToCheck, fetched, locations, existence, maxIssues)
+
+	result := FindMissingModulesResult{
+		Pages: make([]PageBrokenLinksResult, 0, len(errResults)+len(successResults)),
+	}
+	result.Pages = append(result.Pages, errResults...)
+	result.Pages = append(result.Pages, successResults...)
+	for _, pr := range successResults {
+		result.MissingCount += pr.BrokenCount
+	}
+	result.PagesChecked = len(result.Pages)
+	return result, nil
+}
@@ -0,0 +1,65 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFindMissingModules(t *testing.T) {
+	const content = "Intro {{#invoke:Citation|cite|title=Foo}}\n" +
+		"{{ #invoke: Missing box | render }} and {{#invoke:Citation|cite}}\n" +
+		"[[Missing Page]] is an ordinary link"
+	existing := map[string]bool{"Module:Citation": true}
+
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("prop") == "revisions" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{"pages": map[string]interface{}{
+					"1": map[string]interface{}{
+						"pageid": float64(1), "title": "Article",
+						"revisions": []interface{}{map[string]interface{}{
+							"slots": map[string]interface{}{"main": map[string]interface{}{"*": content}},
+						}},
+					},
+				}},
+			})
+			return
+		}
+		pages := map[string]interface{}{}
+		for i, title := range strings.Split(r.FormValue("titles"), "|") {
+			page := map[string]interface{}{"title": title}
+			if !existing[title] {
+				page["missing"] = ""
+			}
+			pages[strconv.Itoa(-1-i)] = page
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"query": map[string]interface{}{"pages": pages}})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.FindMissingModules(context.Background(), FindMissingModulesArgs{Pages: []string{"Article"}})
+	if err != nil {
+		t.Fatalf("FindMissingModules: %v", err)
+	}
+	if result.PagesChecked != 1 || result.MissingCount != 1 {
+		t.Fatalf("result = %+v, want 1 page with 1 missing module", result)
+	}
+	missing := result.Pages[0].BrokenLinks
+	if len(missing) != 1 || missing[0].Target != "Module:Missing box" || missing[0].Line != 2 {
+		t.Errorf("missing modules = %+v, want Module:Missing box on line 2", missing)
+	}
+}
+
+func TestExtractModuleInvocations(t *testing.T) {
+	got := extractModuleInvocations("Page", "{{#invoke:Foo|bar}} {{#INVOKE:Module:Baz|qux}} {{#if:x|y}}", 0)
+	if len(got) != 2 || got[0].target != "Module:Foo" || got[1].target != "Module:Baz" {
+		t.Errorf("invocations = %+v, want Module:Foo and Module:Baz", got)
+	}
+}
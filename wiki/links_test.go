@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -478,6 +480,158 @@ func TestFindOrphanedPages_WithPrefix(t *testing.T) {
 	}
 }
 
+func TestFindDeadEndPages_NamespaceFilter(t *testing.T) {
+	var qppage, infoTitles string
+	server := createLinksMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.FormValue("list") == "querypage" {
+			qppage = r.FormValue("qppage")
+			response := map[string]interface{}{
+				"query": map[string]interface{}{
+					"querypage": map[string]interface{}{
+						"name": "Deadendpages",
+						"results": []interface{}{
+							map[string]interface{}{"ns": float64(0), "title": "Stub Page", "value": "0"},
+							map[string]interface{}{"ns": float64(2), "title": "User:Alice/Draft", "value": "0"},
+							map[string]interface{}{"ns": float64(0), "title": "Another Stub", "value": "0"},
+						},
+					},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		if r.FormValue("prop") == "info" {
+			infoTitles = r.FormValue("titles")
+			response := map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"201": map[string]interface{}{
+							"pageid":  float64(201),
+							"title":   "Stub Page",
+							"length":  float64(120),
+							"touched": "2026-03-01T00:00:00Z",
+						},
+						"202": map[string]interface{}{
+							"pageid": float64(202),
+							"title":  "Another Stub",
+							"length": float64(80),
+						},
+					},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	client := createLinksTestClient(t, server)
+	defer client.Close()
+
+	result, err := client.FindDeadEndPages(context.Background(), FindDeadEndPagesArgs{Namespace: 0})
+	if err != nil {
+		t.Fatalf("FindDeadEndPages failed: %v", err)
+	}
+
+	if qppage != "Deadendpages" {
+		t.Errorf("qppage = %q, want Deadendpages", qppage)
+	}
+	if strings.Contains(infoTitles, "User:Alice/Draft") {
+		t.Errorf("info requested for %q, want the user-namespace page filtered out", infoTitles)
+	}
+	if result.TotalChecked != 3 || result.DeadEndCount != 2 {
+		t.Fatalf("result = %+v, want 2 dead-end pages out of 3 checked", result)
+	}
+	for _, page := range result.DeadEndPages {
+		if page.Title == "Stub Page" && (page.PageID != 201 || page.Length != 120 || page.LastEdited != "2026-03-01T00:00:00Z") {
+			t.Errorf("Stub Page = %+v, want page info filled in", page)
+		}
+	}
+}
+
+// newLinkCountMockServer serves a querypage report named qppage with the
+// given entries and records the requested page in gotQppage.
+func newLinkCountMockServer(t *testing.T, qppage string, entries []interface{}, gotQppage *string) *httptest.Server {
+	t.Helper()
+	return createLinksMockServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("list") != "querypage" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		*gotQppage = r.FormValue("qppage")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{
+				"querypage": map[string]interface{}{
+					"name":    qppage,
+					"results": entries,
+				},
+			},
+		})
+	})
+}
+
+func TestGetMostLinkedPages(t *testing.T) {
+	var qppage string
+	server := newLinkCountMockServer(t, "Mostlinked", []interface{}{
+		map[string]interface{}{"ns": float64(0), "title": "Main Page", "value": "120"},
+		map[string]interface{}{"ns": float64(10), "title": "Template:Infobox", "value": "87"},
+		map[string]interface{}{"ns": float64(0), "title": "Glossary", "value": "45"},
+	}, &qppage)
+	defer server.Close()
+
+	client := createLinksTestClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetMostLinkedPages(context.Background(), GetMostLinkedPagesArgs{})
+	if err != nil {
+		t.Fatalf("GetMostLinkedPages failed: %v", err)
+	}
+	if qppage != "Mostlinked" {
+		t.Errorf("qppage = %q, want Mostlinked", qppage)
+	}
+	want := []PageLinkCount{{Title: "Main Page", Links: 120}, {Title: "Glossary", Links: 45}}
+	if result.Count != 2 || !reflect.DeepEqual(result.Pages, want) {
+		t.Errorf("pages = %+v, want %+v", result.Pages, want)
+	}
+
+	all, err := client.GetMostLinkedPages(context.Background(), GetMostLinkedPagesArgs{Namespace: -1})
+	if err != nil {
+		t.Fatalf("GetMostLinkedPages (all namespaces) failed: %v", err)
+	}
+	if all.Count != 3 || all.Pages[1].Namespace != 10 || all.Pages[1].Links != 87 {
+		t.Errorf("all namespaces = %+v, want 3 pages including the template", all.Pages)
+	}
+}
+
+func TestGetWantedPages(t *testing.T) {
+	var qppage string
+	server := newLinkCountMockServer(t, "Wantedpages", []interface{}{
+		map[string]interface{}{"ns": float64(0), "title": "Onboarding Guide", "value": "31"},
+		map[string]interface{}{"ns": float64(0), "title": "Release Process", "value": "9"},
+	}, &qppage)
+	defer server.Close()
+
+	client := createLinksTestClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetWantedPages(context.Background(), GetWantedPagesArgs{Limit: 10})
+	if err != nil {
+		t.Fatalf("GetWantedPages failed: %v", err)
+	}
+	if qppage != "Wantedpages" {
+		t.Errorf("qppage = %q, want Wantedpages", qppage)
+	}
+	if result.Count != 2 || result.Pages[0].Title != "Onboarding Guide" || result.Pages[0].Links != 31 {
+		t.Errorf("pages = %+v, want Onboarding Guide first with 31 links", result.Pages)
+	}
+}
+
 func TestFindBrokenInternalLinks_NoInput(t *testing.T) {
 	server := createLinksMockServer(t, func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -774,6 +928,29 @@ func TestFindBrokenInternalLinks_Success(t *testing.T) {
 	}
 }
 
+func TestBuildBrokenLinksResults_MaxIssuesPerPage(t *testing.T) {
+	var locations []linkLocation
+	for i := 0; i < 5; i++ {
+		locations = append(locations, linkLocation{pageTitle: "Page", target: fmt.Sprintf("Missing %d", i), line: i + 1})
+	}
+	locations = append(locations, linkLocation{pageTitle: "Page", target: "Exists", line: 9})
+	fetched := map[string]struct{}{"Page": {}}
+	existence := map[string]bool{"Exists": true}
+
+	capped := buildBrokenLinksResults([]string{"Page"}, fetched, locations, existence, 3)[0]
+	if len(capped.BrokenLinks) != 3 || capped.BrokenCount != 5 || !capped.Truncated {
+		t.Errorf("capped = %d listed, count %d, truncated %v; want 3 listed of 5, truncated", len(capped.BrokenLinks), capped.BrokenCount, capped.Truncated)
+	}
+	if capped.BrokenLinks[2].Target != "Missing 2" {
+		t.Errorf("listed links should be the first found, got %+v", capped.BrokenLinks)
+	}
+
+	full := buildBrokenLinksResults([]string{"Page"}, fetched, locations, existence, 5)[0]
+	if len(full.BrokenLinks) != 5 || full.BrokenCount != 5 || full.Truncated {
+		t.Errorf("at the cap = %d listed, count %d, truncated %v; want all 5, not truncated", len(full.BrokenLinks), full.BrokenCount, full.Truncated)
+	}
+}
+
 func TestFindBrokenInternalLinks_EmptyPages(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -0,0 +1,106 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GetNamespaces returns the wiki's namespace IDs keyed by lower-case name.
+// Local names, canonical (English) names and aliases are all included, so
+// "Template", "template" and a localized name resolve alike. The map is
+// cached with the wiki info.
+func (c *Client) GetNamespaces(ctx context.Context) (map[string]int, error) {
+	cacheKey := "namespaces"
+	if cached, ok := c.getCached(cacheKey); ok {
+		return cached.(map[string]int), nil
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("meta", "siteinfo")
+	params.Set("siprop", "namespaces|namespacealiases")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := resp["query"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected API response: missing 'query' object")
+	}
+
+	namespaces := make(map[string]int)
+	add := func(name string, id int) {
+		if name = namespaceKey(name); name != "" {
+			namespaces[name] = id
+		}
+	}
+	for _, raw := range getMap(query["namespaces"]) {
+		ns := getMap(raw)
+		id := getInt(ns["id"])
+		add(getString(ns["*"]), id)
+		add(getString(ns["name"]), id)
+		add(getString(ns["canonical"]), id)
+	}
+	for _, raw := range getSlice(query["namespacealiases"]) {
+		alias := getMap(raw)
+		id := getInt(alias["id"])
+		add(getString(alias["*"]), id)
+		add(getString(alias["alias"]), id)
+	}
+	if len(namespaces) == 0 {
+		return nil, fmt.Errorf("unexpected API response: no namespaces returned")
+	}
+
+	c.setCache(cacheKey, namespaces, "wiki_info")
+	return namespaces, nil
+}
+
+// ResolveNamespace translates a namespace given as a number ("10") or a name
+// ("Template", "template:", "User_talk") to its ID. Numbers pass through
+// without an API call; names are looked up in GetNamespaces. An empty name
+// is the main namespace.
+func (c *Client) ResolveNamespace(ctx context.Context, ref string) (int, error) {
+	ref = strings.TrimSpace(ref)
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+	key := namespaceKey(strings.TrimSuffix(ref, ":"))
+	if key == "" || key == "main" || key == "(main)" {
+		return 0, nil
+	}
+
+	namespaces, err := c.GetNamespaces(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve namespace %q: %w", ref, err)
+	}
+	if id, ok := namespaces[key]; ok {
+		return id, nil
+	}
+
+	known := make([]string, 0, len(namespaces))
+	for name := range namespaces {
+		known = append(known, name)
+	}
+	sort.Strings(known)
+	return 0, &ValidationError{
+		Field:      "namespace",
+		Value:      ref,
+		Message:    fmt.Sprintf("unknown namespace %q", ref),
+		Suggestion: "Use a namespace ID (0 = main, 10 = Template, 14 = Category) or one of: " + strings.Join(known, ", "),
+	}
+}
+
+// namespaceKey normalizes a namespace name for lookup: lower case, with
+// underscores as spaces.
+func namespaceKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(strings.ReplaceAll(name, "_", " ")))
+}
@@ -0,0 +1,60 @@
+package wiki
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestResolveNamespace(t *testing.T) {
+	var siteinfoCalls atomic.Int32
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("meta") == "siteinfo" {
+			siteinfoCalls.Add(1)
+			_, _ = w.Write([]byte(`{"query":{
+				"namespaces":{
+					"0":{"id":0,"case":"first-letter","*":""},
+					"3":{"id":3,"case":"first-letter","canonical":"User talk","*":"Brukerdiskusjon"},
+					"6":{"id":6,"case":"first-letter","canonical":"File","*":"File"},
+					"10":{"id":10,"case":"first-letter","canonical":"Template","*":"Template"}
+				},
+				"namespacealiases":[{"id":6,"*":"Image"}]
+			}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"query":{}}`))
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	tests := []struct {
+		ref  string
+		want int
+	}{
+		{"Template", 10},
+		{"template:", 10},
+		{"10", 10},
+		{" 14 ", 14},
+		{"User_talk", 3},
+		{"Brukerdiskusjon", 3},
+		{"Image", 6},
+		{"", 0},
+		{"Main", 0},
+	}
+	for _, tt := range tests {
+		got, err := client.ResolveNamespace(context.Background(), tt.ref)
+		if err != nil || got != tt.want {
+			t.Errorf("ResolveNamespace(%q) = %d, %v; want %d", tt.ref, got, err, tt.want)
+		}
+	}
+	if siteinfoCalls.Load() != 1 {
+		t.Errorf("siteinfo fetched %d times, want 1 (cached per client)", siteinfoCalls.Load())
+	}
+
+	if _, err := client.ResolveNamespace(context.Background(), "Nonexistent"); err == nil {
+		t.Error("expected an error for an unknown namespace")
+	}
+}
@@ -0,0 +1,178 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// MaxNullEditPages caps a single NullEditPages call. Existence is checked
+// in MaxBatchSize chunks; every page is then saved with its own edit request.
+const MaxNullEditPages = 200
+
+// nullEditInterval is the pause between consecutive saves, keeping a bulk
+// null edit from flooding the wiki's job queue. Tests set it to zero.
+var nullEditInterval = 500 * time.Millisecond
+
+// NullEditPages null-edits each page by appending empty text. MediaWiki
+// applies the append to whatever revision is current when the save lands,
+// so no content is ever sent back and an edit made in the meantime cannot
+// be reverted; it creates no revision but re-parses the page, which
+// refreshes category and link tables after a template change when a purge
+// is not enough. Pages are processed in order; a failure on one page is
+// recorded in its result and does not stop the rest.
+func (c *Client) NullEditPages(ctx context.Context, args NullEditPagesArgs) (NullEditPagesResult, error) {
+	if len(args.Pages) == 0 {
+		return NullEditPagesResult{}, fmt.Errorf("at least one page is required")
+	}
+	if len(args.Pages) > MaxNullEditPages {
+		return NullEditPagesResult{}, NewBatchTooLargeError(len(args.Pages), MaxNullEditPages)
+	}
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return NullEditPagesResult{}, fmt.Errorf("authentication required for null edits: %w", err)
+	}
+
+	result := NullEditPagesResult{
+		DryRun:  c.config.DryRun,
+		Results: make([]NullEditResult, 0, len(args.Pages)),
+	}
+	saved := 0
+	for start := 0; start < len(args.Pages); start += MaxBatchSize {
+		chunk := args.Pages[start:min(start+MaxBatchSize, len(args.Pages))]
+		existing, err := c.fetchExistingTitles(ctx, chunk)
+		for _, title := range chunk {
+			pageResult := NullEditResult{Title: title}
+			switch {
+			case err != nil:
+				pageResult.Error = err.Error()
+			case !existing[normalizePageTitle(title)]:
+				pageResult.Error = "page does not exist"
+			default:
+				if saved > 0 {
+					select {
+					case <-time.After(nullEditInterval):
+					case <-ctx.Done():
+						return result, fmt.Errorf("context canceled between null edits: %w", ctx.Err())
+					}
+				}
+				saved++
+				if err := c.nullEditPage(ctx, title); err != nil {
+					pageResult.Error = err.Error()
+				} else {
+					pageResult.Success = true
+				}
+			}
+			result.add(pageResult)
+		}
+	}
+
+	result.PagesProcessed = len(result.Results)
+	result.Message = fmt.Sprintf("Null-edited %d of %d pages", result.SuccessCount, result.PagesProcessed)
+	if result.DryRun {
+		result.Message = fmt.Sprintf("Dry run: %d of %d pages would be null-edited", result.SuccessCount, result.PagesProcessed)
+	}
+	return result, nil
+}
+
+// add records one page result, updating the success/failure counters.
+func (r *NullEditPagesResult) add(pageResult NullEditResult) {
+	if pageResult.Success {
+		r.SuccessCount++
+	} else {
+		r.FailureCount++
+	}
+	r.Results = append(r.Results, pageResult)
+}
+
+// fetchExistingTitles reports which pages in titles exist, keyed by
+// normalized title and by each submitted spelling MediaWiki normalized.
+func (c *Client) fetchExistingTitles(ctx context.Context, titles []string) (map[string]bool, error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("titles", strings.Join(normalizeTitles(titles), "|"))
+	params.Set("prop", "info")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check pages: %w", err)
+	}
+	query, pages, err := extractQueryPages(resp, "unexpected API response")
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(pages))
+	for _, pageData := range pages {
+		page := getMap(pageData)
+		if page == nil {
+			continue
+		}
+		if _, missing := page["missing"]; missing {
+			continue
+		}
+		existing[getString(page["title"])] = true
+	}
+	// Map the submitted spellings onto MediaWiki's normalized titles
+	for _, n := range getSlice(query["normalized"]) {
+		norm := getMap(n)
+		if existing[getString(norm["to"])] {
+			existing[getString(norm["from"])] = true
+		}
+	}
+	return existing, nil
+}
+
+// nullEditPage saves an empty append to title. nocreate guards against
+// recreating a page deleted since its existence was checked. It bypasses
+// performEdit, so it applies the editable-namespace allowlist itself.
+func (c *Client) nullEditPage(ctx context.Context, title string) error {
+	if err := c.checkEditableNamespace(ctx, title); err != nil {
+		return err
+	}
+	if c.config.DryRun {
+		c.logDryRun(AuditOpNullEdit, title, "", "")
+		return nil
+	}
+
+	resp, err := retryOnBadToken(c, func() (map[string]interface{}, error) {
+		token, err := c.getCSRFToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+		params := url.Values{}
+		params.Set("action", "edit")
+		params.Set("title", title)
+		params.Set("appendtext", "")
+		params.Set("nocreate", "1")
+		params.Set("token", token)
+		return c.apiRequest(ctx, params)
+	})
+	if err != nil {
+		return err
+	}
+
+	edit := getMap(resp["edit"])
+	if edit == nil {
+		return fmt.Errorf("unexpected API response: missing 'edit' object")
+	}
+	success := getString(edit["result"]) == "Success"
+	errMsg := ""
+	if !success {
+		errMsg = fmt.Sprintf("Edit failed: %s", getString(edit["result"]))
+	}
+	c.logAudit(AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Operation: AuditOpNullEdit,
+		Title:     title,
+		PageID:    getInt(edit["pageid"]),
+		WikiURL:   c.config.BaseURL,
+		Success:   success,
+		Error:     errMsg,
+	})
+	if !success {
+		return fmt.Errorf("%s", errMsg)
+	}
+	return nil
+}
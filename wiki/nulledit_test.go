@@ -0,0 +1,111 @@
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestNullEditPages_AppendsNothing(t *testing.T) {
+	saved := nullEditInterval
+	nullEditInterval = 0
+	defer func() { nullEditInterval = saved }()
+
+	contents := map[string]string{
+		"Alpha": "{{Infobox}}\nText with trailing spaces   \n[[Category:Things]]\n",
+		"Beta":  "Ünïcödé — and <nowiki>[[not a link]]</nowiki>",
+	}
+	var mu sync.Mutex
+	submitted := map[string]nullEditSubmission{}
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var resp map[string]interface{}
+		switch r.FormValue("action") {
+		case "query":
+			pages := map[string]interface{}{}
+			for i, title := range strings.Split(r.FormValue("titles"), "|") {
+				content, ok := contents[title]
+				if !ok {
+					pages["-1"] = map[string]interface{}{"title": title, "missing": ""}
+					continue
+				}
+				pages[strconv.Itoa(i+1)] = map[string]interface{}{
+					"pageid": float64(i + 1), "title": title,
+					"revisions": []interface{}{map[string]interface{}{
+						"revid": float64(10 + i), "timestamp": "2026-10-01T00:00:00Z",
+						"slots": map[string]interface{}{"main": map[string]interface{}{"*": content}},
+					}},
+				}
+			}
+			resp = map[string]interface{}{"query": map[string]interface{}{"pages": pages}}
+		case "edit":
+			mu.Lock()
+			_, sentText := r.PostForm["text"]
+			appendText, sentAppend := r.PostForm["appendtext"]
+			submitted[r.FormValue("title")] = nullEditSubmission{
+				sentText:    sentText,
+				emptyAppend: sentAppend && len(appendText) == 1 && appendText[0] == "",
+				nocreate:    r.FormValue("nocreate"),
+			}
+			mu.Unlock()
+			resp = map[string]interface{}{"edit": map[string]interface{}{
+				"result": "Success", "title": r.FormValue("title"), "pageid": float64(1), "nochange": "",
+			}}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+	var audit bytes.Buffer
+	client.SetAuditLogger(NewWriterAuditLogger(&audit, client.logger))
+
+	result, err := client.NullEditPages(context.Background(), NullEditPagesArgs{Pages: []string{"Alpha", "Missing", "Beta"}})
+	if err != nil {
+		t.Fatalf("NullEditPages: %v", err)
+	}
+	if result.SuccessCount != 2 || result.FailureCount != 1 || result.PagesProcessed != 3 {
+		t.Fatalf("result = %+v, want 2 successes and 1 failure", result)
+	}
+	if r := result.Results[1]; r.Title != "Missing" || r.Success || r.Error == "" {
+		t.Errorf("Missing = %+v, want a failure", r)
+	}
+	for title := range contents {
+		got, ok := submitted[title]
+		if !ok {
+			t.Errorf("%s was not re-saved", title)
+			continue
+		}
+		if got.sentText || !got.emptyAppend {
+			t.Errorf("%s saved with %+v, want only an empty appendtext", title, got)
+		}
+		if got.nocreate != "1" {
+			t.Errorf("%s saved without nocreate", title)
+		}
+	}
+	if _, ok := submitted["Missing"]; ok {
+		t.Error("missing page was saved")
+	}
+	if n := strings.Count(audit.String(), `"operation":"null_edit"`); n != 2 {
+		t.Errorf("audit log has %d null_edit entries, want 2:\n%s", n, audit.String())
+	}
+
+	if _, err := client.NullEditPages(context.Background(), NullEditPagesArgs{}); err == nil {
+		t.Error("expected an error for an empty page list")
+	}
+	if _, err := client.NullEditPages(context.Background(), NullEditPagesArgs{Pages: make([]string, MaxNullEditPages+1)}); err == nil {
+		t.Error("expected an error for a batch over the limit")
+	}
+}
+
+// nullEditSubmission records the edit parameters a null edit submitted.
+type nullEditSubmission struct {
+	sentText    bool
+	emptyAppend bool
+	nocreate    string
+}
@@ -58,7 +58,11 @@ func (c *Client) ListPages(ctx context.Context, args ListPagesArgs) (ListPagesRe
 		return ListPagesResult{}, fmt.Errorf("unexpected response format: missing query")
 	}
 
-	pages := parsePageSummaries(getSlice(query["allpages"]))
+	allpages, ok := query["allpages"].([]interface{})
+	if !ok {
+		return ListPagesResult{}, fmt.Errorf("unexpected response format: missing allpages list")
+	}
+	pages := parsePageSummaries(allpages)
 	result := ListPagesResult{
 		Pages:         pages,
 		ReturnedCount: len(pages),
@@ -265,6 +269,17 @@ func (c *Client) GetWikiInfo(ctx context.Context, args WikiInfoArgs) (WikiInfo,
 		Timezone:    getString(general["timezone"]),
 		WriteAPI:    general["writeapi"] != nil,
 	}
+	for _, v := range getSlice(general["variants"]) {
+		variant := getMap(v)
+		if code := getString(variant["code"]); code != "" {
+			info.Variants = append(info.Variants, LanguageVariant{Code: code, Name: getString(variant["name"])})
+		}
+	}
+	for _, f := range getSlice(general["fallback"]) {
+		if code := getString(getMap(f)["code"]); code != "" {
+			info.LanguageFallbacks = append(info.LanguageFallbacks, code)
+		}
+	}
 
 	// Statistics
 	if stats, ok := query["statistics"].(map[string]interface{}); ok {
@@ -474,6 +474,13 @@ func TestGetWikiInfo_AllStats(t *testing.T) {
 						"timeoffset":  float64(0),
 						"wikiid":      "testwiki",
 						"phpversion":  "8.1.0",
+						"lang":        "zh",
+						"fallback":    []interface{}{map[string]interface{}{"code": "zh-hans"}},
+						"variants": []interface{}{
+							map[string]interface{}{"code": "zh", "name": "中文"},
+							map[string]interface{}{"code": "zh-hans", "name": "中文（简体）"},
+							map[string]interface{}{"code": "zh-hant", "name": "中文（繁體）"},
+						},
 					},
 					"statistics": map[string]interface{}{
 						"pages":       float64(1000),
@@ -518,4 +525,11 @@ func TestGetWikiInfo_AllStats(t *testing.T) {
 	if result.Statistics.Pages != 1000 {
 		t.Errorf("Pages = %d, want 1000", result.Statistics.Pages)
 	}
+
+	if len(result.Variants) != 3 || result.Variants[2] != (LanguageVariant{Code: "zh-hant", Name: "中文（繁體）"}) {
+		t.Errorf("Variants = %+v, want zh, zh-hans and zh-hant", result.Variants)
+	}
+	if len(result.LanguageFallbacks) != 1 || result.LanguageFallbacks[0] != "zh-hans" {
+		t.Errorf("LanguageFallbacks = %v, want [zh-hans]", result.LanguageFallbacks)
+	}
 }
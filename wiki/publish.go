@@ -0,0 +1,77 @@
+package wiki
+
+import (
+	"context"
+
+	"github.com/olgasafonova/mediawiki-mcp-server/converter"
+)
+
+// PublishMarkdown converts each page with the Markdown converter and saves
+// it through EditPage, so dry-run mode, the namespace allowlist and the edit
+// guardrails apply to every page. A failure on one page does not stop the
+// rest of the batch.
+func (c *Client) PublishMarkdown(ctx context.Context, args PublishMarkdownArgs) (PublishMarkdownResult, error) {
+	if len(args.Pages) == 0 {
+		return PublishMarkdownResult{}, &ValidationError{
+			Field:   "pages",
+			Message: "at least one page is required",
+		}
+	}
+	if len(args.Pages) > MaxBatchSize {
+		return PublishMarkdownResult{}, NewBatchTooLargeError(len(args.Pages), MaxBatchSize)
+	}
+
+	config := converter.DefaultConfig()
+	if args.Theme != "" {
+		config.Theme = args.Theme
+	}
+	summary := args.Summary
+	if summary == "" {
+		summary = "Imported from Markdown"
+	}
+
+	result := PublishMarkdownResult{Preview: args.Preview, Pages: make([]PublishedPage, 0, len(args.Pages))}
+	for _, page := range args.Pages {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		published := PublishedPage{Title: page.Title}
+		wikitext := converter.Convert(page.Markdown, config)
+
+		if args.Preview {
+			published.Success = page.Title != ""
+			published.Wikitext = wikitext
+			if !published.Success {
+				published.Error = "title is required"
+			}
+		} else {
+			edit, err := c.EditPage(ctx, EditPageArgs{
+				BaseWriteArgs: args.BaseWriteArgs,
+				Title:         page.Title,
+				Content:       wikitext,
+				Summary:       summary,
+				CreateOnly:    !args.Overwrite,
+			})
+			switch {
+			case err != nil:
+				published.Error = err.Error()
+			case !edit.Success:
+				published.Error = edit.Message
+			default:
+				published.Success = true
+				published.RevisionID = edit.RevisionID
+				published.PageURL = edit.PageURL
+				published.NewPage = edit.NewPage
+				published.DryRun = edit.DryRun
+			}
+		}
+
+		if published.Success {
+			result.SuccessCount++
+		} else {
+			result.FailureCount++
+		}
+		result.Pages = append(result.Pages, published)
+	}
+	return result, nil
+}
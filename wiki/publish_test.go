@@ -0,0 +1,139 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// publishMockHandler serves the page-size lookup and edits an EditPage-based
+// publish makes. Pages in existing reject createonly edits with
+// articleexists; saved texts are recorded in saved.
+func publishMockHandler(existing map[string]bool, saved map[string]string) http.HandlerFunc {
+	var mu sync.Mutex
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var resp map[string]interface{}
+		switch r.FormValue("action") {
+		case "query":
+			title := r.FormValue("titles")
+			page := map[string]interface{}{"title": title, "missing": ""}
+			if existing[title] {
+				page = map[string]interface{}{"title": title, "length": float64(10)}
+			}
+			resp = map[string]interface{}{"query": map[string]interface{}{
+				"pages": map[string]interface{}{"-1": page},
+			}}
+		case "edit":
+			title := r.FormValue("title")
+			if existing[title] && r.FormValue("createonly") == "1" {
+				resp = map[string]interface{}{"error": map[string]interface{}{
+					"code": "articleexists", "info": "The article you tried to create has been created already.",
+				}}
+				break
+			}
+			if title == "Filtered" {
+				resp = map[string]interface{}{"edit": map[string]interface{}{
+					"result": "Failure", "title": title, "info": "Hit AbuseFilter: Spam links",
+				}}
+				break
+			}
+			mu.Lock()
+			saved[title] = r.FormValue("text")
+			mu.Unlock()
+			resp = map[string]interface{}{"edit": map[string]interface{}{
+				"result": "Success", "title": title, "pageid": float64(7), "newrevid": float64(100), "new": "",
+			}}
+		default:
+			resp = map[string]interface{}{}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+func TestPublishMarkdown(t *testing.T) {
+	saved := map[string]string{}
+	server := mockMediaWikiServer(t, publishMockHandler(map[string]bool{"Existing": true}, saved))
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.PublishMarkdown(context.Background(), PublishMarkdownArgs{
+		Pages: []MarkdownPage{
+			{Title: "Guide", Markdown: "# Guide\n**bold** text"},
+			{Title: "Existing", Markdown: "- item"},
+			{Title: "Notes", Markdown: "- one\n- two"},
+			{Title: "Filtered", Markdown: "Buy now"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PublishMarkdown: %v", err)
+	}
+	if result.SuccessCount != 2 || result.FailureCount != 2 || len(result.Pages) != 4 {
+		t.Fatalf("result = %+v, want 2 successes and 2 failures", result)
+	}
+	if p := result.Pages[3]; p.Success || !strings.Contains(p.Error, "AbuseFilter") {
+		t.Errorf("Filtered = %+v, want the refused edit reported as a failure", p)
+	}
+	if !strings.Contains(saved["Guide"], "=Guide=") || !strings.Contains(saved["Guide"], "'''bold'''") {
+		t.Errorf("Guide saved as %q, want converted wikitext", saved["Guide"])
+	}
+	if !strings.Contains(saved["Notes"], "* one\n* two") {
+		t.Errorf("Notes saved as %q, want a wikitext list", saved["Notes"])
+	}
+	if _, ok := saved["Existing"]; ok {
+		t.Error("existing page was overwritten without overwrite set")
+	}
+	if p := result.Pages[1]; p.Success || !strings.Contains(p.Error, "articleexists") {
+		t.Errorf("Existing = %+v, want an articleexists failure", p)
+	}
+	if p := result.Pages[0]; p.RevisionID != 100 || !p.NewPage {
+		t.Errorf("Guide = %+v, want revision 100 reported as a new page", p)
+	}
+}
+
+func TestPublishMarkdown_PreviewAndDryRun(t *testing.T) {
+	saved := map[string]string{}
+	server := mockMediaWikiServer(t, publishMockHandler(nil, saved))
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+	preview, err := client.PublishMarkdown(context.Background(), PublishMarkdownArgs{
+		Pages:   []MarkdownPage{{Title: "Guide", Markdown: "# Guide"}, {Markdown: "# Untitled"}},
+		Preview: true,
+	})
+	if err != nil {
+		t.Fatalf("preview: %v", err)
+	}
+	if preview.SuccessCount != 1 || preview.Pages[0].Wikitext != "=Guide=" || preview.Pages[1].Error == "" {
+		t.Errorf("preview = %+v, want converted wikitext and a missing-title failure", preview)
+	}
+
+	dryClient := createMockClient(t, server)
+	defer dryClient.Close()
+	dryClient.config.DryRun = true
+	dry, err := dryClient.PublishMarkdown(context.Background(), PublishMarkdownArgs{
+		Pages: []MarkdownPage{{Title: "Guide", Markdown: "# Guide"}},
+	})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if !dry.Pages[0].Success || !dry.Pages[0].DryRun {
+		t.Errorf("dry run = %+v, want a successful dry-run result", dry.Pages[0])
+	}
+	if len(saved) != 0 {
+		t.Errorf("saved = %v, want nothing saved in preview or dry-run mode", saved)
+	}
+
+	tooMany := make([]MarkdownPage, MaxBatchSize+1)
+	if _, err := client.PublishMarkdown(context.Background(), PublishMarkdownArgs{Pages: tooMany}); err == nil {
+		t.Error("expected an error for a batch over the limit")
+	}
+	if _, err := client.PublishMarkdown(context.Background(), PublishMarkdownArgs{}); err == nil {
+		t.Error("expected an error for an empty batch")
+	}
+}
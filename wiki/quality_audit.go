@@ -174,6 +174,10 @@ func (c *Client) HealthAudit(ctx context.Context, args WikiHealthAuditArgs) (Wik
 		checksToRun = []string{"links", "terminology", "orphans", "activity"}
 	}
 	limit := normalizeLimit(args.Limit, 20, 50)
+	if args.ExplainOnly {
+		result.Plan = c.planHealthAudit(ctx, args, checksToRun, limit, &result)
+		return result, nil
+	}
 	registry := c.healthAuditChecks()
 
 	var mu sync.Mutex
@@ -202,6 +206,62 @@ func (c *Client) HealthAudit(ctx context.Context, args WikiHealthAuditArgs) (Wik
 	return result, nil
 }
 
+// planHealthAudit resolves the audit's target pages and estimates the API
+// calls each requested check would make, without running any of them.
+// Problems that would make a check fail are recorded in result.Errors.
+func (c *Client) planHealthAudit(ctx context.Context, args WikiHealthAuditArgs, checks []string, limit int, result *WikiHealthAuditResult) *HealthAuditPlan {
+	plan := &HealthAuditPlan{Checks: make([]PlannedHealthCheck, 0, len(checks)), Pages: make([]string, 0)}
+	known := c.healthAuditChecks()
+
+	pages, pagesErr := c.collectPagesFromArgs(ctx, args.Pages, args.Category, limit, "pages")
+	if pagesErr == nil {
+		plan.Pages = pages
+		plan.PageCount = len(pages)
+	}
+	// Each page-scoped check lists the category again on its own
+	listing := 0
+	if len(args.Pages) == 0 && args.Category != "" {
+		listing = 1
+	}
+
+	for _, name := range checks {
+		if _, ok := known[name]; !ok {
+			plan.UnknownChecks = append(plan.UnknownChecks, name)
+			continue
+		}
+		check := PlannedHealthCheck{Name: name, Scope: "pages"}
+		switch name {
+		case "links":
+			check.EstimatedAPICalls = listing + plan.PageCount + 1
+			check.Note = "Fetches each page, then checks link targets in batches of 50"
+		case "terminology":
+			check.EstimatedAPICalls = listing + 1 + plan.PageCount
+			check.Note = "Loads the glossary page, then fetches each page"
+		case "orphans":
+			check.Scope = "wiki"
+			check.EstimatedAPICalls = 1 + (limit+MaxBatchSize-1)/MaxBatchSize
+			check.Note = fmt.Sprintf("Lists up to %d orphaned pages in the main namespace", limit)
+		case "activity":
+			check.Scope = "wiki"
+			check.EstimatedAPICalls = 1
+			check.Note = fmt.Sprintf("Summarizes the last %d recent changes", limit)
+		case "external":
+			plan.ExternalSample = samplePagesForExternalCheck(ctx, c, args, 5)
+			if len(plan.ExternalSample) > 0 {
+				plan.ExternalSample = plan.ExternalSample[:1]
+			}
+			check.EstimatedAPICalls = listing + 1
+			check.Note = "Fetches the first sampled page and requests up to 10 of its external URLs"
+		}
+		if check.Scope == "pages" && pagesErr != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s check would fail: %v", name, pagesErr))
+		}
+		plan.EstimatedAPICalls += check.EstimatedAPICalls
+		plan.Checks = append(plan.Checks, check)
+	}
+	return plan
+}
+
 // extractExternalURLs extracts external URLs from wiki content
 func extractExternalURLs(content string, limit int) []string {
 	// Match URLs in external link syntax [http...] or bare URLs
@@ -0,0 +1,157 @@
+package wiki
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// balanceIgnoredRegex matches regions whose content is displayed literally
+// by MediaWiki, so delimiters inside them never need to balance.
+var balanceIgnoredRegex = regexp.MustCompile(`(?is)<!--.*?-->|<nowiki\s*/>|<nowiki\b[^>]*>.*?</nowiki>|<pre\b[^>]*>.*?</pre>|<syntaxhighlight\b[^>]*>.*?</syntaxhighlight>|<source\b[^>]*>.*?</source>|<code\b[^>]*>.*?</code>|<math\b[^>]*>.*?</math>`)
+
+// balanceCloser maps each opening delimiter to its closing delimiter.
+var balanceCloser = map[string]string{
+	"{{{":   "}}}",
+	"{{":    "}}",
+	"{|":    "|}",
+	"[[":    "]]",
+	"<ref>": "</ref>",
+}
+
+// balanceOpener is an opening delimiter waiting for its closer.
+type balanceOpener struct {
+	token string
+	line  int
+}
+
+// balanceScanner tracks open delimiters while scanning wikitext.
+type balanceScanner struct {
+	stack  []balanceOpener
+	issues []BalanceIssue
+}
+
+// CheckWikitextBalance scans wikitext for unbalanced template braces ({{ }}),
+// template parameters ({{{ }}}), tables ({| |}), links ([[ ]]), and <ref>
+// tags, reporting each unmatched delimiter with its 1-based line number.
+// Content inside comments, nowiki, pre, code, math, and syntaxhighlight
+// blocks is ignored. It makes no API calls, so it is cheap enough to run on
+// every preview.
+func CheckWikitextBalance(wikitext string) []BalanceIssue {
+	masked := maskBalanceIgnored(wikitext)
+	s := &balanceScanner{}
+	for i, line := range strings.Split(masked, "\n") {
+		s.scanLine(line, i+1)
+	}
+	for _, open := range s.stack {
+		s.unclosed(open)
+	}
+	sort.SliceStable(s.issues, func(i, j int) bool {
+		return s.issues[i].Line < s.issues[j].Line
+	})
+	return s.issues
+}
+
+// maskBalanceIgnored blanks out ignored regions while keeping newlines, so
+// line numbers in the masked text match the original.
+func maskBalanceIgnored(wikitext string) string {
+	return balanceIgnoredRegex.ReplaceAllStringFunc(wikitext, func(m string) string {
+		return strings.Repeat("\n", strings.Count(m, "\n"))
+	})
+}
+
+// scanLine processes the delimiters on a single line.
+func (s *balanceScanner) scanLine(line string, lineNum int) {
+	trimmed := strings.TrimLeft(line, " \t")
+	offset := len(line) - len(trimmed)
+	switch {
+	case strings.HasPrefix(trimmed, "{|"):
+		s.push("{|", lineNum)
+		offset += 2
+	case strings.HasPrefix(trimmed, "|}"):
+		s.close("{|", lineNum)
+		offset += 2
+	}
+
+	lower := strings.ToLower(line)
+	for i := offset; i < len(line); {
+		switch {
+		case strings.HasPrefix(lower[i:], "</ref>"):
+			s.close("<ref>", lineNum)
+			i += len("</ref>")
+		case strings.HasPrefix(lower[i:], "<ref>") || strings.HasPrefix(lower[i:], "<ref "):
+			end := strings.Index(line[i:], ">")
+			if end == -1 {
+				i = len(line)
+				continue
+			}
+			if !strings.HasSuffix(strings.TrimSpace(line[i:i+end]), "/") {
+				s.push("<ref>", lineNum)
+			}
+			i += end + 1
+		case strings.HasPrefix(line[i:], "{{{"):
+			s.push("{{{", lineNum)
+			i += 3
+		case strings.HasPrefix(line[i:], "{{"):
+			s.push("{{", lineNum)
+			i += 2
+		case strings.HasPrefix(line[i:], "}}}") && s.top() == "{{{":
+			s.close("{{{", lineNum)
+			i += 3
+		case strings.HasPrefix(line[i:], "}}"):
+			s.close("{{", lineNum)
+			i += 2
+		case strings.HasPrefix(line[i:], "[["):
+			s.push("[[", lineNum)
+			i += 2
+		case strings.HasPrefix(line[i:], "]]"):
+			s.close("[[", lineNum)
+			i += 2
+		default:
+			i++
+		}
+	}
+}
+
+// top returns the most recent open delimiter, or "" when none is open.
+func (s *balanceScanner) top() string {
+	if len(s.stack) == 0 {
+		return ""
+	}
+	return s.stack[len(s.stack)-1].token
+}
+
+func (s *balanceScanner) push(token string, lineNum int) {
+	s.stack = append(s.stack, balanceOpener{token: token, line: lineNum})
+}
+
+// close matches a closing delimiter to the nearest open delimiter of the same
+// kind. Delimiters opened after that one are reported as unclosed; a closer
+// with no matching opener is reported as unexpected.
+func (s *balanceScanner) close(opener string, lineNum int) {
+	for i := len(s.stack) - 1; i >= 0; i-- {
+		if s.stack[i].token != opener {
+			continue
+		}
+		for _, open := range s.stack[i+1:] {
+			s.unclosed(open)
+		}
+		s.stack = s.stack[:i]
+		return
+	}
+	closer := balanceCloser[opener]
+	s.issues = append(s.issues, BalanceIssue{
+		Line:    lineNum,
+		Token:   closer,
+		Message: fmt.Sprintf("'%s' has no matching '%s'", closer, opener),
+	})
+}
+
+func (s *balanceScanner) unclosed(open balanceOpener) {
+	s.issues = append(s.issues, BalanceIssue{
+		Line:    open.line,
+		Token:   open.token,
+		Message: fmt.Sprintf("'%s' is never closed with '%s'", open.token, balanceCloser[open.token]),
+	})
+}
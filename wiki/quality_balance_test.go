@@ -0,0 +1,103 @@
+package wiki
+
+import "testing"
+
+func TestCheckWikitextBalance(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantTokens []string
+		wantLines  []int
+	}{
+		{
+			name:  "balanced page",
+			input: "{{Infobox\n|name=Foo\n}}\nSee [[Main Page]].<ref>Source</ref>\n{|\n| cell\n|}",
+		},
+		{
+			name:  "nested templates and parameters",
+			input: "{{Outer|{{Inner|{{{1|default}}}}}}}",
+		},
+		{
+			name:  "self-closing ref",
+			input: `Text<ref name="a" /> more <ref name="b">B</ref>`,
+		},
+		{
+			name:  "delimiters inside code blocks ignored",
+			input: "<nowiki>{{</nowiki>\n<pre>[[</pre>\n<!-- }} -->\n<syntaxhighlight lang=\"js\">{|</syntaxhighlight>",
+		},
+		{
+			name:       "unclosed template",
+			input:      "Intro\n{{Infobox\n|name=Foo",
+			wantTokens: []string{"{{"},
+			wantLines:  []int{2},
+		},
+		{
+			name:       "unexpected template close",
+			input:      "Text }}\n",
+			wantTokens: []string{"}}"},
+			wantLines:  []int{1},
+		},
+		{
+			name:       "unclosed table",
+			input:      "{|\n| a\n| b",
+			wantTokens: []string{"{|"},
+			wantLines:  []int{1},
+		},
+		{
+			name:       "table close without open",
+			input:      "| a\n|}",
+			wantTokens: []string{"|}"},
+			wantLines:  []int{2},
+		},
+		{
+			name:       "unclosed link",
+			input:      "See [[Main Page for details.",
+			wantTokens: []string{"[["},
+			wantLines:  []int{1},
+		},
+		{
+			name:       "unclosed ref",
+			input:      "Claim.<ref>Source\n\nNext paragraph.",
+			wantTokens: []string{"<ref>"},
+			wantLines:  []int{1},
+		},
+		{
+			name:       "stray ref close",
+			input:      "Claim.</ref>",
+			wantTokens: []string{"</ref>"},
+			wantLines:  []int{1},
+		},
+		{
+			name:       "link left open inside template",
+			input:      "{{Note|see [[Foo}}\nline two",
+			wantTokens: []string{"[["},
+			wantLines:  []int{1},
+		},
+		{
+			name:       "multiple issues sorted by line",
+			input:      "{{A\n]]\n[[B",
+			wantTokens: []string{"{{", "]]", "[["},
+			wantLines:  []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := CheckWikitextBalance(tt.input)
+			if len(issues) != len(tt.wantTokens) {
+				t.Fatalf("got %d issues %+v, want %d", len(issues), issues, len(tt.wantTokens))
+			}
+			for i, issue := range issues {
+				if issue.Token != tt.wantTokens[i] {
+					t.Errorf("issues[%d].Token = %q, want %q", i, issue.Token, tt.wantTokens[i])
+				}
+				if issue.Line != tt.wantLines[i] {
+					t.Errorf("issues[%d].Line = %d, want %d", i, issue.Line, tt.wantLines[i])
+				}
+				if issue.Message == "" {
+					t.Errorf("issues[%d].Message is empty", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// refTagRegex matches <ref ...>body</ref> and self-closing <ref ... />
+	// tags, but not <references/>.
+	refTagRegex = regexp.MustCompile(`(?is)<ref(\s[^>]*?)?\s*(?:/>|>(.*?)</ref\s*>)`)
+	// refAttrRegex extracts the name and group attributes of a <ref> tag,
+	// quoted or not.
+	refAttrRegex = regexp.MustCompile(`(?i)\b(name|group)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'/>]+))`)
+)
+
+// GetCitations lists the <ref> citations on a page with their usage counts.
+func (c *Client) GetCitations(ctx context.Context, args GetCitationsArgs) (GetCitationsResult, error) {
+	if args.Title == "" {
+		return GetCitationsResult{}, fmt.Errorf("title is required")
+	}
+	page, err := c.GetPage(ctx, GetPageArgs{Title: args.Title, Format: "wikitext"})
+	if err != nil {
+		return GetCitationsResult{}, err
+	}
+
+	citations, refCount := parseCitations(page.Content)
+	return GetCitationsResult{
+		Title:     page.Title,
+		RefCount:  refCount,
+		Citations: citations,
+	}, nil
+}
+
+// FindDuplicateCitations reports citation bodies that are defined more than
+// once on a page, either under different names or repeated inline, so they
+// can be merged into a single named reference.
+func (c *Client) FindDuplicateCitations(ctx context.Context, args FindDuplicateCitationsArgs) (FindDuplicateCitationsResult, error) {
+	if args.Title == "" {
+		return FindDuplicateCitationsResult{}, fmt.Errorf("title is required")
+	}
+	page, err := c.GetPage(ctx, GetPageArgs{Title: args.Title, Format: "wikitext"})
+	if err != nil {
+		return FindDuplicateCitationsResult{}, err
+	}
+
+	citations, _ := parseCitations(page.Content)
+	duplicates := findDuplicateCitations(citations)
+	result := FindDuplicateCitationsResult{
+		Title:      page.Title,
+		Duplicates: duplicates,
+		Message:    "No duplicate citations found",
+	}
+	if len(duplicates) > 0 {
+		result.Message = fmt.Sprintf("Found %d citation(s) defined more than once", len(duplicates))
+	}
+	return result, nil
+}
+
+// parseCitations extracts the distinct citations from wikitext in order of
+// first appearance, returning them with the total number of <ref> tags.
+// Refs inside comments, nowiki, and code blocks are ignored.
+func parseCitations(content string) ([]Citation, int) {
+	content = balanceIgnoredRegex.ReplaceAllString(content, "")
+
+	citations := []Citation{}
+	index := make(map[string]int)
+	refCount := 0
+	for _, m := range refTagRegex.FindAllStringSubmatch(content, -1) {
+		name, group := refAttributes(m[1])
+		body := strings.TrimSpace(m[2])
+
+		var key string
+		switch {
+		case name != "":
+			key = "name\x00" + group + "\x00" + name
+		case body != "":
+			key = "body\x00" + group + "\x00" + normalizeWhitespace(body)
+		default:
+			continue // an empty unnamed <ref/> cites nothing
+		}
+		refCount++
+
+		i, ok := index[key]
+		if !ok {
+			i = len(citations)
+			index[key] = i
+			citations = append(citations, Citation{Name: name, Group: group})
+		}
+		citations[i].Uses++
+		if citations[i].Content == "" {
+			citations[i].Content = body
+		}
+	}
+
+	for i := range citations {
+		citations[i].Undefined = citations[i].Name != "" && citations[i].Content == ""
+	}
+	return citations, refCount
+}
+
+// refAttributes returns the name and group attributes from a <ref> tag's
+// attribute text.
+func refAttributes(attrs string) (name, group string) {
+	for _, m := range refAttrRegex.FindAllStringSubmatch(attrs, -1) {
+		value := strings.TrimSpace(m[2] + m[3] + m[4])
+		if strings.EqualFold(m[1], "name") {
+			name = value
+		} else {
+			group = value
+		}
+	}
+	return name, group
+}
+
+// findDuplicateCitations groups citations by whitespace-normalized body and
+// group, returning the bodies that have more than one definition: several
+// names, or an unnamed inline ref repeated or alongside a named one.
+func findDuplicateCitations(citations []Citation) []DuplicateCitation {
+	duplicates := []DuplicateCitation{}
+	index := make(map[string]int)
+	for _, cit := range citations {
+		if cit.Content == "" {
+			continue
+		}
+		key := cit.Group + "\x00" + normalizeWhitespace(cit.Content)
+		i, ok := index[key]
+		if !ok {
+			i = len(duplicates)
+			index[key] = i
+			duplicates = append(duplicates, DuplicateCitation{Content: cit.Content})
+		}
+		d := &duplicates[i]
+		if cit.Name != "" {
+			d.Names = append(d.Names, cit.Name)
+		} else {
+			d.InlineCount += cit.Uses
+		}
+		d.Uses += cit.Uses
+	}
+
+	result := make([]DuplicateCitation, 0, len(duplicates))
+	for _, d := range duplicates {
+		if len(d.Names)+d.InlineCount < 2 {
+			continue
+		}
+		d.Suggestion = duplicateCitationSuggestion(d)
+		result = append(result, d)
+	}
+	return result
+}
+
+// duplicateCitationSuggestion explains how to merge a duplicated citation.
+func duplicateCitationSuggestion(d DuplicateCitation) string {
+	if len(d.Names) == 0 {
+		return `Name the first occurrence (<ref name="...">) and replace the repeats with <ref name="..." />`
+	}
+	return fmt.Sprintf(`Keep <ref name="%s"> and replace the other definitions with <ref name="%s" />`, d.Names[0], d.Names[0])
+}
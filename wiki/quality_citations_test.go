@@ -0,0 +1,103 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+const citationTestWikitext = `Intro.<ref name="smith">Smith, J. (2020). ''Widgets''.</ref>
+Reused claim.<ref name="smith" />
+Another claim.<ref name=jones>Jones, A. (2019). ''Gadgets''.</ref>
+Same source again.<ref name='smith2'>Smith, J. (2020).  ''Widgets''.</ref>
+Inline one.<ref>https://example.com/report</ref>
+Inline two.<ref>https://example.com/report</ref>
+Missing body.<ref name="ghost"/>
+Note.<ref group="note">A footnote.</ref>
+<!-- <ref name="hidden">Commented out</ref> -->
+<references />`
+
+func TestParseCitations(t *testing.T) {
+	citations, refCount := parseCitations(citationTestWikitext)
+
+	if refCount != 8 {
+		t.Errorf("refCount = %d, want 8", refCount)
+	}
+	want := []Citation{
+		{Name: "smith", Content: "Smith, J. (2020). ''Widgets''.", Uses: 2},
+		{Name: "jones", Content: "Jones, A. (2019). ''Gadgets''.", Uses: 1},
+		{Name: "smith2", Content: "Smith, J. (2020).  ''Widgets''.", Uses: 1},
+		{Content: "https://example.com/report", Uses: 2},
+		{Name: "ghost", Uses: 1, Undefined: true},
+		{Group: "note", Content: "A footnote.", Uses: 1},
+	}
+	if !reflect.DeepEqual(citations, want) {
+		t.Errorf("parseCitations() =\n%+v\nwant\n%+v", citations, want)
+	}
+}
+
+func TestFindDuplicateCitations(t *testing.T) {
+	citations, _ := parseCitations(citationTestWikitext)
+	got := findDuplicateCitations(citations)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d duplicates, want 2: %+v", len(got), got)
+	}
+	if !reflect.DeepEqual(got[0].Names, []string{"smith", "smith2"}) || got[0].Uses != 3 {
+		t.Errorf("duplicate[0] = %+v, want names smith and smith2 with 3 uses", got[0])
+	}
+	if got[1].Content != "https://example.com/report" || got[1].InlineCount != 2 || len(got[1].Names) != 0 {
+		t.Errorf("duplicate[1] = %+v, want the repeated inline URL", got[1])
+	}
+	if got[0].Suggestion == "" || got[1].Suggestion == "" {
+		t.Error("expected merge suggestions on every duplicate")
+	}
+}
+
+func TestGetCitations(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{
+				"pages": map[string]interface{}{
+					"1": map[string]interface{}{
+						"pageid": float64(1),
+						"title":  "Sources",
+						"revisions": []interface{}{
+							map[string]interface{}{
+								"slots": map[string]interface{}{
+									"main": map[string]interface{}{"content": citationTestWikitext},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetCitations(context.Background(), GetCitationsArgs{Title: "Sources"})
+	if err != nil {
+		t.Fatalf("GetCitations: %v", err)
+	}
+	if result.Title != "Sources" || len(result.Citations) != 6 || result.RefCount != 8 {
+		t.Errorf("GetCitations = %+v, want 6 citations from 8 refs", result)
+	}
+
+	dups, err := client.FindDuplicateCitations(context.Background(), FindDuplicateCitationsArgs{Title: "Sources"})
+	if err != nil {
+		t.Fatalf("FindDuplicateCitations: %v", err)
+	}
+	if len(dups.Duplicates) != 2 {
+		t.Errorf("FindDuplicateCitations found %d duplicates, want 2", len(dups.Duplicates))
+	}
+
+	if _, err := client.GetCitations(context.Background(), GetCitationsArgs{}); err == nil {
+		t.Error("expected an error for a missing title")
+	}
+}
@@ -0,0 +1,79 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// minInlinedTemplateLength is the shortest normalized template body that is
+// searched for. Shorter bodies match too much ordinary prose to be useful.
+const minInlinedTemplateLength = 20
+
+// FindInlinedTemplateContent flags pages whose wikitext contains the
+// expanded text of a template pasted inline, rather than transcluding the
+// template. Whitespace is normalized on both sides before comparing, so
+// re-wrapped or re-indented copies are still found.
+func (c *Client) FindInlinedTemplateContent(ctx context.Context, args FindInlinedTemplateContentArgs) (FindInlinedTemplateContentResult, error) {
+	if strings.TrimSpace(args.TemplateName) == "" {
+		return FindInlinedTemplateContentResult{}, fmt.Errorf("template_name is required")
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return FindInlinedTemplateContentResult{}, err
+	}
+
+	template := templateTitle(args.TemplateName)
+	name := strings.TrimPrefix(template, "Template:")
+	expanded, err := c.expandWikitext(ctx, "{{"+name+"}}", "")
+	if err != nil {
+		return FindInlinedTemplateContentResult{}, fmt.Errorf("failed to expand '%s': %w", template, err)
+	}
+	needle := normalizeWhitespace(expanded)
+	if len(needle) < minInlinedTemplateLength {
+		return FindInlinedTemplateContentResult{}, fmt.Errorf("expanded content of '%s' is too short (%d characters) to detect reliably", template, len(needle))
+	}
+
+	limit := normalizeLimit(args.Limit, 10, 50)
+	pagesToCheck, err := c.collectPagesFromArgs(ctx, args.Pages, args.Category, limit, "pages")
+	if err != nil {
+		return FindInlinedTemplateContentResult{}, err
+	}
+
+	result := FindInlinedTemplateContentResult{
+		Template:       template,
+		TemplateLength: len(needle),
+		Matches:        make([]InlinedTemplateMatch, 0),
+	}
+	suggestion := fmt.Sprintf("Replace the pasted text with {{%s}}", name)
+	for _, title := range pagesToCheck {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		page, err := c.GetPage(ctx, GetPageArgs{Title: title, Format: "wikitext"})
+		result.PagesChecked++
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", title, err))
+			continue
+		}
+		if strings.Contains(normalizeWhitespace(page.Content), needle) {
+			result.Matches = append(result.Matches, InlinedTemplateMatch{
+				Title:      page.Title,
+				Suggestion: suggestion,
+			})
+		}
+	}
+
+	result.MatchesFound = len(result.Matches)
+	result.Message = fmt.Sprintf("%d of %d pages contain the content of %s inline", result.MatchesFound, result.PagesChecked, template)
+	return result, nil
+}
+
+// normalizeWhitespace collapses every run of whitespace to a single space
+// and trims the ends.
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
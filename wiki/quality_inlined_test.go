@@ -0,0 +1,73 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestFindInlinedTemplateContent(t *testing.T) {
+	const body = "'''Note:''' This page is maintained by the Platform team.\nAsk in #platform for help."
+	pages := map[string]string{
+		"Pasted":      "Intro.\n\n'''Note:''' This page is   maintained by the Platform team.\n  Ask in #platform for help.\n\nMore.",
+		"Transcluded": "Intro.\n\n{{Maintainer notice}}\n\nMore.",
+	}
+
+	var expandText string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "expandtemplates":
+			expandText = r.FormValue("text")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"expandtemplates": map[string]interface{}{"wikitext": body},
+			})
+		case "query":
+			title := r.FormValue("titles")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"1": map[string]interface{}{
+							"pageid": float64(1),
+							"title":  title,
+							"revisions": []interface{}{
+								map[string]interface{}{
+									"slots": map[string]interface{}{
+										"main": map[string]interface{}{"content": pages[title]},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.FindInlinedTemplateContent(context.Background(), FindInlinedTemplateContentArgs{
+		TemplateName: "Template:Maintainer notice",
+		Pages:        []string{"Pasted", "Transcluded"},
+	})
+	if err != nil {
+		t.Fatalf("FindInlinedTemplateContent failed: %v", err)
+	}
+	if expandText != "{{Maintainer notice}}" {
+		t.Errorf("expanded text = %q, want {{Maintainer notice}}", expandText)
+	}
+	if result.PagesChecked != 2 {
+		t.Errorf("PagesChecked = %d, want 2", result.PagesChecked)
+	}
+	if result.MatchesFound != 1 || result.Matches[0].Title != "Pasted" {
+		t.Fatalf("Matches = %+v, want only the page with pasted content", result.Matches)
+	}
+	if result.Matches[0].Suggestion != "Replace the pasted text with {{Maintainer notice}}" {
+		t.Errorf("Suggestion = %q", result.Matches[0].Suggestion)
+	}
+}
@@ -0,0 +1,221 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// spellingWordRegex matches a single word: a letter followed by letters,
+// digits, apostrophes or hyphens.
+var spellingWordRegex = regexp.MustCompile(`\p{L}[\p{L}\p{N}'’-]*`)
+
+// spellingMarkupRegexes match wikitext that is not prose and is masked
+// before words are checked: comments, HTML tags with their attributes,
+// quoted attributes (tables), template names and named parameters, URLs,
+// entities and magic words. Template values and tag contents are kept.
+var spellingMarkupRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`(?s)<!--.*?-->`),
+	regexp.MustCompile(`<[^<>\n]*>`),
+	regexp.MustCompile(`[\w-]+\s*=\s*"[^"\n]*"`),
+	regexp.MustCompile(`\{\{\s*[^|{}\n]*`),
+	regexp.MustCompile(`\|\s*[\p{L}\p{N}_ -]+\s*=`),
+	regexp.MustCompile(`(?i)\b(?:https?|ftp)://[^\s\[\]|<>]+|\bwww\.[^\s\[\]|<>]+`),
+	regexp.MustCompile(`&(?:[a-zA-Z]+|#\w+);`),
+	regexp.MustCompile(`__[A-Z]+__`),
+}
+
+// spellingLinkRegex matches an internal link without nested links,
+// capturing its target and optional "|label" part.
+var spellingLinkRegex = regexp.MustCompile(`\[\[([^\[\]|\n]*)(\|[^\[\]\n]*)?\]\]`)
+
+// spellingDictionary holds the approved and forbidden spellings loaded from
+// a dictionary page. Both maps are keyed by lowercase word; allowed maps to
+// the approved spelling and denied to the suggested replacement (if any).
+type spellingDictionary struct {
+	allowed map[string]string
+	denied  map[string]string
+}
+
+// CheckSpelling checks pages against a team-maintained spelling dictionary.
+// Words on the denylist are always flagged, and so are words missing from
+// the allowlist (other than stopwords). Words on the allowlist are flagged
+// when written with a different spelling or capitalization (e.g.
+// "Javascript" when "JavaScript" is approved). Code blocks and wikitext
+// markup (link targets, template names, tags, URLs) are skipped.
+func (c *Client) CheckSpelling(ctx context.Context, args CheckSpellingArgs) (CheckSpellingResult, error) {
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return CheckSpellingResult{}, err
+	}
+
+	dictionaryPage := args.DictionaryPage
+	if dictionaryPage == "" {
+		dictionaryPage = "Spelling Dictionary"
+	}
+
+	page, err := c.GetPage(ctx, GetPageArgs{Title: dictionaryPage, Format: "wikitext"})
+	if err != nil {
+		return CheckSpellingResult{}, fmt.Errorf("failed to load dictionary from '%s': %w", dictionaryPage, err)
+	}
+	dict := parseSpellingDictionary(page.Content)
+	if len(dict.allowed) == 0 && len(dict.denied) == 0 {
+		return CheckSpellingResult{}, fmt.Errorf("no words found in dictionary page '%s'", dictionaryPage)
+	}
+
+	limit := normalizeLimit(args.Limit, 10, 50)
+	pagesToCheck, err := c.collectPagesFromArgs(ctx, args.Pages, args.Category, limit, "pages")
+	if err != nil {
+		return CheckSpellingResult{}, err
+	}
+
+	maxIssues := normalizeLimit(args.MaxIssuesPerPage, DefaultMaxIssuesPerPage, maxIssuesPerPageLimit)
+	result := CheckSpellingResult{
+		DictionaryPage: dictionaryPage,
+		AllowedWords:   len(dict.allowed),
+		DeniedWords:    len(dict.denied),
+		Pages:          make([]PageSpellingResult, 0, len(pagesToCheck)),
+	}
+	for _, title := range pagesToCheck {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		pageResult := PageSpellingResult{Title: title, Issues: make([]SpellingIssue, 0)}
+		page, err := c.GetPage(ctx, GetPageArgs{Title: title, Format: "wikitext"})
+		if err != nil {
+			pageResult.Error = err.Error()
+		} else {
+			pageResult.Title = page.Title
+			pageResult.Issues, pageResult.IssueCount = checkSpellingInContent(stripCodeBlocksForTerminology(page.Content), dict, maxIssues)
+			pageResult.Truncated = pageResult.IssueCount > len(pageResult.Issues)
+		}
+		result.IssuesFound += pageResult.IssueCount
+		result.Pages = append(result.Pages, pageResult)
+	}
+
+	result.PagesChecked = len(result.Pages)
+	return result, nil
+}
+
+// parseSpellingDictionary reads a dictionary page. Each table row is
+// | word || status || suggestion |, where status is "allow" or "deny"
+// (synonyms such as "approved" and "avoid" are accepted) and the optional
+// suggestion is offered for denied words.
+func parseSpellingDictionary(content string) spellingDictionary {
+	dict := spellingDictionary{allowed: map[string]string{}, denied: map[string]string{}}
+	for _, cells := range glossaryTableRows(content) {
+		if len(cells) < 2 {
+			continue
+		}
+		word := strings.TrimSpace(cells[0])
+		if word == "" {
+			continue
+		}
+		key := strings.ToLower(word)
+		switch strings.ToLower(strings.TrimSpace(cells[1])) {
+		case "allow", "allowed", "approved", "ok", "yes":
+			dict.allowed[key] = word
+		case "deny", "denied", "avoid", "forbidden", "no":
+			suggestion := ""
+			if len(cells) >= 3 {
+				suggestion = strings.TrimSpace(cells[2])
+			}
+			dict.denied[key] = suggestion
+		}
+	}
+	return dict
+}
+
+// checkSpellingInContent returns the dictionary violations in content,
+// listing at most maxIssues (0 means no cap) along with the total found.
+// Every word must be on the allowlist; stopwords are exempt, and numbers
+// never match spellingWordRegex. Capitalizing the first letter of an
+// all-lowercase approved word (as at the start of a sentence) is accepted.
+// Markup is masked first, so only the text a reader sees is checked.
+func checkSpellingInContent(content string, dict spellingDictionary, maxIssues int) ([]SpellingIssue, int) {
+	issues := make([]SpellingIssue, 0)
+	total := 0
+	masked := strings.Split(maskWikiMarkup(content), "\n")
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, m := range spellingWordRegex.FindAllStringIndex(masked[lineNum], -1) {
+			word := line[m[0]:m[1]]
+			key := strings.ToLower(word)
+			issue := SpellingIssue{Word: word, Line: lineNum + 1}
+			if suggestion, ok := dict.denied[key]; ok {
+				issue.Type = "denied"
+				issue.Suggestion = suggestion
+			} else if approved, ok := dict.allowed[key]; !ok && !stopwords[key] {
+				issue.Type = "unknown"
+			} else if ok && !matchesApprovedSpelling(word, approved) {
+				issue.Type = "variant"
+				issue.Suggestion = approved
+			} else {
+				continue
+			}
+			total++
+			if maxIssues > 0 && len(issues) >= maxIssues {
+				continue
+			}
+			issue.Context = extractContext(line, m[0], m[1], 40)
+			issues = append(issues, issue)
+		}
+	}
+	return issues, total
+}
+
+// maskWikiMarkup blanks out the markup matched by spellingMarkupRegexes and
+// spellingLinkRegex. Each masked byte becomes a space (newlines are kept),
+// so offsets and line numbers in the result match content. A link keeps
+// only its label; links without one, and namespaced links such as
+// categories and files, are masked whole.
+func maskWikiMarkup(content string) string {
+	b := []byte(content)
+	mask := func(start, end int) {
+		for i := start; i < end; i++ {
+			if b[i] != '\n' {
+				b[i] = ' '
+			}
+		}
+	}
+	for _, re := range spellingMarkupRegexes {
+		for _, m := range re.FindAllIndex(b, -1) {
+			mask(m[0], m[1])
+		}
+	}
+	// Inner links are masked first so that a file link whose caption holds
+	// links matches on a later pass.
+	for {
+		matches := spellingLinkRegex.FindAllSubmatchIndex(b, -1)
+		if len(matches) == 0 {
+			break
+		}
+		for _, m := range matches {
+			target := string(b[m[2]:m[3]])
+			if m[4] < 0 || strings.Contains(strings.TrimPrefix(target, ":"), ":") {
+				mask(m[0], m[1])
+				continue
+			}
+			mask(m[0], m[4]+1) // "[[target|"
+			mask(m[1]-2, m[1]) // "]]"
+		}
+	}
+	return string(b)
+}
+
+// matchesApprovedSpelling reports whether word is written as approved, or
+// is an all-lowercase approved word with only its first letter capitalized.
+func matchesApprovedSpelling(word, approved string) bool {
+	if word == approved {
+		return true
+	}
+	if approved != strings.ToLower(approved) {
+		return false
+	}
+	r, size := utf8.DecodeRuneInString(approved)
+	return word == string(unicode.ToUpper(r))+approved[size:]
+}
@@ -0,0 +1,177 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testSpellingDictionary = `Approved spellings.
+{| class="wikitable"
+! Word !! Status !! Suggestion
+|-
+| JavaScript || allow
+|-
+| send || allow
+|-
+| team || allow
+|-
+| add || allow
+|-
+| host || allow
+|-
+| written || allow
+|-
+| uses || allow
+|-
+| deploy || allow
+|-
+| e-mail || deny || email
+|-
+| whitelist || deny || allowlist
+|}`
+
+func TestCheckSpelling(t *testing.T) {
+	pages := map[string]string{
+		"Spelling Dictionary": testSpellingDictionary,
+		"Guide": "Send an e-mail to the team.\n" +
+			"Add the host to the Whitelist.\n" +
+			"Written in Javascript.\n" +
+			"<syntaxhighlight lang=\"js\">const whitelist = [];</syntaxhighlight>\n" +
+			"Uses JavaScript.\n" +
+			"Deploy to the servr.",
+	}
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("action") != "query" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		title := r.FormValue("titles")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{
+				"pages": map[string]interface{}{
+					"1": map[string]interface{}{
+						"pageid": float64(1),
+						"title":  title,
+						"revisions": []interface{}{
+							map[string]interface{}{
+								"slots": map[string]interface{}{
+									"main": map[string]interface{}{"content": pages[title]},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.CheckSpelling(context.Background(), CheckSpellingArgs{Pages: []string{"Guide"}})
+	if err != nil {
+		t.Fatalf("CheckSpelling failed: %v", err)
+	}
+	if result.AllowedWords != 8 || result.DeniedWords != 2 {
+		t.Errorf("loaded allowed=%d denied=%d, want 8 and 2", result.AllowedWords, result.DeniedWords)
+	}
+
+	issues := result.Pages[0].Issues
+	want := []SpellingIssue{
+		{Word: "e-mail", Type: "denied", Suggestion: "email", Line: 1},
+		{Word: "Whitelist", Type: "denied", Suggestion: "allowlist", Line: 2},
+		{Word: "Javascript", Type: "variant", Suggestion: "JavaScript", Line: 3},
+		{Word: "servr", Type: "unknown", Line: 6},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("got %d issues %+v, want %d (code block must be skipped)", len(issues), issues, len(want))
+	}
+	for i, w := range want {
+		got := issues[i]
+		if got.Word != w.Word || got.Type != w.Type || got.Suggestion != w.Suggestion || got.Line != w.Line {
+			t.Errorf("issue[%d] = %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestParseSpellingDictionary_SkipsUnknownStatus(t *testing.T) {
+	dict := parseSpellingDictionary(`{| class="wikitable"
+|-
+| colour || maybe
+|-
+| color || approved
+|}`)
+	if len(dict.allowed) != 1 || dict.allowed["color"] != "color" {
+		t.Errorf("allowed = %v, want only color", dict.allowed)
+	}
+	if len(dict.denied) != 0 {
+		t.Errorf("denied = %v, want none", dict.denied)
+	}
+}
+
+func TestCheckSpellingInContent_UnknownWords(t *testing.T) {
+	dict := spellingDictionary{
+		allowed: map[string]string{"deploy": "deploy", "kubernetes": "Kubernetes"},
+		denied:  map[string]string{},
+	}
+	issues, _ := checkSpellingInContent("Deploy it to Kubernets in 2 steps.", dict, 0)
+	var unknown []string
+	for _, issue := range issues {
+		if issue.Type != "unknown" {
+			t.Errorf("unexpected %s issue for %q", issue.Type, issue.Word)
+		}
+		unknown = append(unknown, issue.Word)
+	}
+	// "it" and "to" are stopwords, "Deploy" is a capitalized approved word
+	// and numbers are never words.
+	if want := []string{"Kubernets", "steps"}; !reflect.DeepEqual(unknown, want) {
+		t.Errorf("unknown words = %q, want %q", unknown, want)
+	}
+}
+
+func TestCheckSpellingInContent_SkipsMarkup(t *testing.T) {
+	dict := spellingDictionary{
+		allowed: map[string]string{
+			"deploy": "deploy", "guide": "guide", "docs": "docs", "server": "server",
+			"first": "first", "note": "note", "see": "see", "logo": "logo",
+		},
+		denied: map[string]string{},
+	}
+	content := `{{Infobox software|name=Deploy guide|website=https://www.example.com/docs}}
+<!-- hidden remark: teh -->
+Deploy the [[Web server|server]] first.<ref name="upstream">See [https://example.org/deploy docs].</ref>
+{| class="wikitable sortable"
+| style="width:50%" | Note
+|}
+[[File:Logo.png|thumb|200px|Logo]] [[Category:Deploy guides]] __NOTOC__ &nbsp;
+Deploy the servr.`
+
+	issues, total := checkSpellingInContent(content, dict, 0)
+	var words []string
+	for _, issue := range issues {
+		words = append(words, issue.Word)
+	}
+	if want := []string{"servr"}; !reflect.DeepEqual(words, want) || total != 1 {
+		t.Errorf("flagged %q (total %d), want only %q", words, total, want)
+	}
+	if len(issues) == 1 && (issues[0].Line != 8 || !strings.Contains(issues[0].Context, "Deploy the servr")) {
+		t.Errorf("issue = %+v, want line 8 with its context", issues[0])
+	}
+}
+
+func TestCheckSpellingInContent_MaxIssues(t *testing.T) {
+	dict := spellingDictionary{allowed: map[string]string{}, denied: map[string]string{}}
+	issues, total := checkSpellingInContent(strings.Repeat("foo bar\n", 10), dict, 5)
+	if len(issues) != 5 || total != 20 {
+		t.Errorf("got %d issues of %d, want 5 listed of 20", len(issues), total)
+	}
+	if issues[4].Line != 3 {
+		t.Errorf("listed issues should be the first found, last is on line %d", issues[4].Line)
+	}
+}
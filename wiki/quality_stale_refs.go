@@ -0,0 +1,115 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultStaleReferencePatterns flag phrasing that pins content to a point
+// in time. A named group "year" restricts a pattern to years before the
+// current one, so "as of <this year>" is not reported.
+var defaultStaleReferencePatterns = []string{
+	`(?i)\b(?:as of|as at|since|last updated|updated|current as of|valid until|until)\s+(?:[a-z]+\s+)?(?:\d{1,2},?\s+)?(?P<year>(?:19|20)\d{2})\b`,
+	`(?i)(?:©|\(c\)|copyright)\s*(?P<year>(?:19|20)\d{2})\b`,
+	`(?i)\b(?:version|release|v)\s?\d+\.\d+(?:\.\d+)*\b`,
+}
+
+// FindStaleReferences scans pages for hardcoded dates and version numbers
+// that tend to go stale, reporting each match with its line and context for
+// human review. Code blocks are skipped.
+func (c *Client) FindStaleReferences(ctx context.Context, args FindStaleReferencesArgs) (FindStaleReferencesResult, error) {
+	patternSrcs := args.Patterns
+	if len(patternSrcs) == 0 {
+		patternSrcs = defaultStaleReferencePatterns
+	}
+	patterns, err := compileStaleReferencePatterns(patternSrcs)
+	if err != nil {
+		return FindStaleReferencesResult{}, err
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return FindStaleReferencesResult{}, err
+	}
+
+	limit := normalizeLimit(args.Limit, 10, 50)
+	pagesToCheck, err := c.collectPagesFromArgs(ctx, args.Pages, args.Category, limit, "pages")
+	if err != nil {
+		return FindStaleReferencesResult{}, err
+	}
+
+	result := FindStaleReferencesResult{
+		Patterns: patternSrcs,
+		Pages:    make([]PageStaleReferences, 0, len(pagesToCheck)),
+	}
+	currentYear := time.Now().Year()
+	for _, title := range pagesToCheck {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		pageResult := PageStaleReferences{Title: title}
+		page, err := c.GetPage(ctx, GetPageArgs{Title: title, Format: "wikitext"})
+		if err != nil {
+			pageResult.Error = err.Error()
+		} else {
+			pageResult.Title = page.Title
+			pageResult.Matches = findStaleReferences(stripCodeBlocksForTerminology(page.Content), patterns, currentYear)
+		}
+		result.MatchesFound += len(pageResult.Matches)
+		result.Pages = append(result.Pages, pageResult)
+	}
+
+	result.PagesChecked = len(result.Pages)
+	return result, nil
+}
+
+// compileStaleReferencePatterns compiles the given regexes, reporting the
+// first one that fails.
+func compileStaleReferencePatterns(srcs []string) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(srcs))
+	for _, src := range srcs {
+		re, err := regexp.Compile(src)
+		if err != nil {
+			return nil, &ValidationError{
+				Field:   "patterns",
+				Value:   src,
+				Message: fmt.Sprintf("invalid regex: %v", err),
+			}
+		}
+		patterns = append(patterns, re)
+	}
+	return patterns, nil
+}
+
+// findStaleReferences returns every pattern match in content. Matches of
+// patterns with a "year" group are kept only when that year is before
+// currentYear.
+func findStaleReferences(content string, patterns []*regexp.Regexp, currentYear int) []StaleReference {
+	var matches []StaleReference
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, re := range patterns {
+			yearGroup := re.SubexpIndex("year")
+			for _, m := range re.FindAllStringSubmatchIndex(line, -1) {
+				if yearGroup >= 0 && m[2*yearGroup] >= 0 {
+					year, err := strconv.Atoi(line[m[2*yearGroup]:m[2*yearGroup+1]])
+					if err != nil || year >= currentYear {
+						continue
+					}
+				}
+				matches = append(matches, StaleReference{
+					Pattern: re.String(),
+					Match:   line[m[0]:m[1]],
+					Line:    lineNum + 1,
+					Context: extractContext(line, m[0], m[1], 40),
+				})
+			}
+		}
+	}
+	return matches
+}
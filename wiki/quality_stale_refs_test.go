@@ -0,0 +1,71 @@
+package wiki
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFindStaleReferences(t *testing.T) {
+	patterns, err := compileStaleReferencePatterns(defaultStaleReferencePatterns)
+	if err != nil {
+		t.Fatalf("default patterns failed to compile: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"past as-of year", "Pricing is correct as of 2023.", []string{"as of 2023"}},
+		{"as-of with month", "Last updated March 2021 by the team.", []string{"Last updated March 2021"}},
+		{"current year ignored", "Accurate as of 2026.", nil},
+		{"future year ignored", "Supported until 2030.", nil},
+		{"historical year ignored", "The company was founded in 1998.", nil},
+		{"copyright year", "© 2019 Example Corp", []string{"© 2019"}},
+		{"version string", "Install version 2.4.1 or later.", []string{"version 2.4.1"}},
+		{"short version", "Requires v3.2 of the SDK.", []string{"v3.2"}},
+		{"plain number ignored", "There are 3.5 million users.", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findStaleReferences(tt.content, patterns, 2026)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d matches %+v, want %v", len(got), got, tt.want)
+			}
+			for i, want := range tt.want {
+				if got[i].Match != want {
+					t.Errorf("match[%d] = %q, want %q", i, got[i].Match, want)
+				}
+				if got[i].Line != 1 || got[i].Context == "" {
+					t.Errorf("match[%d] line=%d context=%q", i, got[i].Line, got[i].Context)
+				}
+			}
+		})
+	}
+}
+
+func TestFindStaleReferences_CustomPatternWithYear(t *testing.T) {
+	patterns, err := compileStaleReferencePatterns([]string{`FY(?P<year>\d{4})`})
+	if err != nil {
+		t.Fatalf("compile failed: %v", err)
+	}
+	got := findStaleReferences("Budget FY2024\nBudget FY2026", patterns, 2026)
+	if len(got) != 1 || got[0].Match != "FY2024" || got[0].Line != 1 {
+		t.Errorf("got %+v, want only FY2024 on line 1", got)
+	}
+}
+
+func TestFindStaleReferences_InvalidPattern(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	_, err := client.FindStaleReferences(context.Background(), FindStaleReferencesArgs{
+		Pages:    []string{"A"},
+		Patterns: []string{"("},
+	})
+	valErr, ok := err.(*ValidationError)
+	if !ok || valErr.Field != "patterns" {
+		t.Fatalf("expected patterns ValidationError, got %T: %v", err, err)
+	}
+}
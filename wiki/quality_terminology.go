@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 func (c *Client) CheckTerminology(ctx context.Context, args CheckTerminologyArgs) (CheckTerminologyResult, error) {
@@ -38,7 +40,8 @@ func (c *Client) CheckTerminology(ctx context.Context, args CheckTerminologyArgs
 	}
 
 	excludeCode := excludeCodeBlocks(args.ExcludeCodeBlocks)
-	if err := c.checkPagesTerminology(ctx, pagesToCheck, glossary, excludeCode, &result); err != nil {
+	maxIssues := normalizeLimit(args.MaxIssuesPerPage, DefaultMaxIssuesPerPage, maxIssuesPerPageLimit)
+	if err := c.checkPagesTerminology(ctx, pagesToCheck, glossary, excludeCode, maxIssues, &result); err != nil {
 		return result, err
 	}
 
@@ -56,14 +59,14 @@ func excludeCodeBlocks(flag *bool) bool {
 
 // checkPagesTerminology checks each page against the glossary, accumulating
 // results. It aborts early on context cancellation.
-func (c *Client) checkPagesTerminology(ctx context.Context, pages []string, glossary []GlossaryTerm, excludeCode bool, result *CheckTerminologyResult) error {
+func (c *Client) checkPagesTerminology(ctx context.Context, pages []string, glossary []GlossaryTerm, excludeCode bool, maxIssues int, result *CheckTerminologyResult) error {
 	for _, pageTitle := range pages {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
 		}
-		pageResult := c.checkPageTerminology(ctx, pageTitle, glossary, excludeCode)
+		pageResult := c.checkPageTerminology(ctx, pageTitle, glossary, excludeCode, maxIssues)
 		result.Pages = append(result.Pages, pageResult)
 		result.IssuesFound += pageResult.IssueCount
 	}
@@ -83,8 +86,9 @@ func (c *Client) loadGlossary(ctx context.Context, glossaryPage string) ([]Gloss
 // glossaryTableRegex matches wikitable blocks tagged with mcp-glossary or wikitable.
 var glossaryTableRegex = regexp.MustCompile(`(?s)\{\|[^\n]*class="[^"]*(?:mcp-glossary|wikitable)[^"]*"[^\n]*\n(.*?)\|\}`)
 
-// glossaryTermFromCells converts parsed table cells into a GlossaryTerm.
-// Returns ok=false for rows that should be skipped (too few cells, empty, or
+// glossaryTermFromCells converts parsed table cells into a GlossaryTerm with
+// its matcher compiled, so each term is compiled once per loaded glossary
+// rather than per page or line. Returns ok=false for rows that should be skipped (too few cells, empty, or
 // where the "incorrect" form already matches the "correct" form).
 func glossaryTermFromCells(cells []string) (GlossaryTerm, bool) {
 	if len(cells) < 2 {
@@ -103,35 +107,38 @@ func glossaryTermFromCells(cells []string) (GlossaryTerm, bool) {
 	if len(cells) >= 4 {
 		term.Notes = strings.TrimSpace(cells[3])
 	}
+	term.matcher = compileTermMatcher(term)
 	return term, true
 }
 
 // parseWikiTableGlossary extracts terms from wikitable format
 func parseWikiTableGlossary(content string) []GlossaryTerm {
 	var terms []GlossaryTerm
-	for _, table := range glossaryTableRegex.FindAllStringSubmatch(content, -1) {
-		if len(table) < 2 {
-			continue
+	for _, cells := range glossaryTableRows(content) {
+		if term, ok := glossaryTermFromCells(cells); ok {
+			terms = append(terms, term)
 		}
-		terms = append(terms, parseGlossaryTableRows(table[1])...)
 	}
 	return terms
 }
 
-// parseGlossaryTableRows parses the rows of a single glossary table body into
-// glossary terms.
-func parseGlossaryTableRows(tableBody string) []GlossaryTerm {
-	var terms []GlossaryTerm
-	for _, row := range strings.Split(tableBody, "|-") {
-		row = strings.TrimSpace(row)
-		if row == "" || strings.HasPrefix(row, "!") {
+// glossaryTableRows returns the cells of every data row in the page's
+// glossary-style wikitables, skipping header rows.
+func glossaryTableRows(content string) [][]string {
+	var rows [][]string
+	for _, table := range glossaryTableRegex.FindAllStringSubmatch(content, -1) {
+		if len(table) < 2 {
 			continue
 		}
-		if term, ok := glossaryTermFromCells(parseTableRow(row)); ok {
-			terms = append(terms, term)
+		for _, row := range strings.Split(table[1], "|-") {
+			row = strings.TrimSpace(row)
+			if row == "" || strings.HasPrefix(row, "!") {
+				continue
+			}
+			rows = append(rows, parseTableRow(row))
 		}
 	}
-	return terms
+	return rows
 }
 
 // parseTableRow extracts cells from a wiki table row
@@ -173,11 +180,34 @@ func compileTermMatcher(term GlossaryTerm) *regexp.Regexp {
 	return re
 }
 
+// findTermIssues returns the terminology issues in content, line by line,
+// using each term's precompiled matcher, together with the total number
+// found. At most maxIssues are returned (maxIssues <= 0 means no cap); the
+// rest are only counted. Terms without a matcher are skipped.
+func findTermIssues(content string, glossary []GlossaryTerm, maxIssues int) ([]TerminologyIssue, int) {
+	issues := make([]TerminologyIssue, 0)
+	total := 0
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, term := range glossary {
+			if term.matcher == nil {
+				continue
+			}
+			found := findTermIssuesInLine(line, lineNum, term)
+			total += len(found)
+			if maxIssues > 0 {
+				found = found[:min(len(found), max(maxIssues-len(issues), 0))]
+			}
+			issues = append(issues, found...)
+		}
+	}
+	return issues, total
+}
+
 // findTermIssuesInLine returns terminology issues for a single (line, term) pair.
 // Skips matches whose text already equals the correct form.
-func findTermIssuesInLine(line string, lineNum int, term GlossaryTerm, re *regexp.Regexp) []TerminologyIssue {
+func findTermIssuesInLine(line string, lineNum int, term GlossaryTerm) []TerminologyIssue {
 	var issues []TerminologyIssue
-	for _, match := range re.FindAllStringIndex(line, -1) {
+	for _, match := range term.matcher.FindAllStringIndex(line, -1) {
 		matchedText := line[match[0]:match[1]]
 		if strings.EqualFold(matchedText, term.Correct) {
 			continue
@@ -193,8 +223,9 @@ func findTermIssuesInLine(line string, lineNum int, term GlossaryTerm, re *regex
 	return issues
 }
 
-// checkPageTerminology checks a single page against the glossary
-func (c *Client) checkPageTerminology(ctx context.Context, title string, glossary []GlossaryTerm, excludeCode bool) PageTerminologyResult {
+// checkPageTerminology checks a single page against the glossary, listing at
+// most maxIssues issues
+func (c *Client) checkPageTerminology(ctx context.Context, title string, glossary []GlossaryTerm, excludeCode bool, maxIssues int) PageTerminologyResult {
 	result := PageTerminologyResult{
 		Title:  title,
 		Issues: make([]TerminologyIssue, 0),
@@ -211,35 +242,42 @@ func (c *Client) checkPageTerminology(ctx context.Context, title string, glossar
 		content = stripCodeBlocksForTerminology(content)
 	}
 
-	// Pre-compile term matchers once per page.
-	matchers := make([]*regexp.Regexp, len(glossary))
-	for i, term := range glossary {
-		matchers[i] = compileTermMatcher(term)
-	}
-
-	for lineNum, line := range strings.Split(content, "\n") {
-		for i, term := range glossary {
-			if matchers[i] == nil {
-				continue
-			}
-			result.Issues = append(result.Issues, findTermIssuesInLine(line, lineNum, term, matchers[i])...)
-		}
-	}
-
-	result.IssueCount = len(result.Issues)
+	result.Issues, result.IssueCount = findTermIssues(content, glossary, maxIssues)
+	result.Truncated = result.IssueCount > len(result.Issues)
 	return result
 }
 
-// extractContext extracts surrounding text for context
+// extractContext extracts up to contextLen bytes either side of
+// line[start:end]. The window is widened to whole runes so multi-byte
+// characters at its edges are never split.
 func extractContext(line string, start, end, contextLen int) string {
-	// Calculate bounds
-	ctxStart := start - contextLen
-	if ctxStart < 0 {
-		ctxStart = 0
+	return contextWindow(line, start, end, contextLen, false)
+}
+
+// extractWordContext is extractContext widened to whole words: each edge
+// moves outward to the nearest whitespace, by at most another contextLen
+// bytes (text without spaces, such as CJK, keeps the rune-aligned edge).
+func extractWordContext(line string, start, end, contextLen int) string {
+	return contextWindow(line, start, end, contextLen, true)
+}
+
+// contextWindow implements extractContext and extractWordContext, adding
+// ellipses where the window is truncated.
+func contextWindow(line string, start, end, contextLen int, wholeWords bool) string {
+	ctxStart := max(start-contextLen, 0)
+	ctxEnd := min(end+contextLen, len(line))
+
+	// Snap outward to rune boundaries
+	for ctxStart > 0 && !utf8.RuneStart(line[ctxStart]) {
+		ctxStart--
 	}
-	ctxEnd := end + contextLen
-	if ctxEnd > len(line) {
-		ctxEnd = len(line)
+	for ctxEnd < len(line) && !utf8.RuneStart(line[ctxEnd]) {
+		ctxEnd++
+	}
+
+	if wholeWords {
+		ctxStart = widenToWordStart(line, ctxStart, contextLen)
+		ctxEnd = widenToWordEnd(line, ctxEnd, contextLen)
 	}
 
 	context := line[ctxStart:ctxEnd]
@@ -255,20 +293,61 @@ func extractContext(line string, start, end, contextLen int) string {
 	return context
 }
 
+// widenToWordStart moves pos back to the start of the word it falls in,
+// looking at most limit bytes back. It returns pos unchanged if no word
+// boundary is that close.
+func widenToWordStart(line string, pos, limit int) int {
+	if pos == 0 {
+		return 0
+	}
+	if r, _ := utf8.DecodeLastRuneInString(line[:pos]); unicode.IsSpace(r) {
+		return pos
+	}
+	floor := max(pos-limit, 0)
+	if i := strings.LastIndexFunc(line[floor:pos], unicode.IsSpace); i >= 0 {
+		_, size := utf8.DecodeRuneInString(line[floor+i:])
+		return floor + i + size
+	}
+	if floor == 0 {
+		return 0
+	}
+	return pos
+}
+
+// widenToWordEnd moves pos forward to the end of the word it falls in,
+// looking at most limit bytes ahead. It returns pos unchanged if no word
+// boundary is that close.
+func widenToWordEnd(line string, pos, limit int) int {
+	if pos == len(line) {
+		return pos
+	}
+	if r, _ := utf8.DecodeRuneInString(line[pos:]); unicode.IsSpace(r) {
+		return pos
+	}
+	ceil := min(pos+limit, len(line))
+	if i := strings.IndexFunc(line[pos:ceil], unicode.IsSpace); i >= 0 {
+		return pos + i
+	}
+	if ceil == len(line) {
+		return ceil
+	}
+	return pos
+}
+
+// terminologyCodeTagRegexes match the code tags whose content terminology
+// checks skip: <syntaxhighlight>, <source>, <pre>, <code>.
+var terminologyCodeTagRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`(?is)<syntaxhighlight[^>]*>(.*?)</syntaxhighlight>`),
+	regexp.MustCompile(`(?is)<source[^>]*>(.*?)</source>`),
+	regexp.MustCompile(`(?is)<pre[^>]*>(.*?)</pre>`),
+	regexp.MustCompile(`(?is)<code[^>]*>(.*?)</code>`),
+}
+
 // stripCodeBlocksForTerminology removes code block content while preserving line structure
 // This prevents false positives on code paths like SI.Data, namespace.Class, etc.
 func stripCodeBlocksForTerminology(content string) string {
 	// Replace content inside code tags with spaces to preserve line numbers
-	// Handles: <syntaxhighlight>, <source>, <pre>, <code>
-	codeTagPatterns := []string{
-		`(?is)<syntaxhighlight[^>]*>(.*?)</syntaxhighlight>`,
-		`(?is)<source[^>]*>(.*?)</source>`,
-		`(?is)<pre[^>]*>(.*?)</pre>`,
-		`(?is)<code[^>]*>(.*?)</code>`,
-	}
-
-	for _, pattern := range codeTagPatterns {
-		re := regexp.MustCompile(pattern)
+	for _, re := range terminologyCodeTagRegexes {
 		content = re.ReplaceAllStringFunc(content, func(match string) string {
 			// Replace the entire match with spaces, preserving newlines
 			var result strings.Builder
@@ -3,12 +3,15 @@ package wiki
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 func TestParseWikiTableGlossary(t *testing.T) {
@@ -305,6 +308,49 @@ func TestExtractContext(t *testing.T) {
 	}
 }
 
+func TestExtractContextUTF8(t *testing.T) {
+	line := "日本語のテキスト keyword 絵文字😀😀 end"
+	start := strings.Index(line, "keyword")
+	end := start + len("keyword")
+	for contextLen := 0; contextLen <= len(line); contextLen++ {
+		for _, extract := range []func(string, int, int, int) string{extractContext, extractWordContext} {
+			got := extract(line, start, end, contextLen)
+			if !utf8.ValidString(got) {
+				t.Fatalf("contextLen %d: invalid UTF-8 in %q", contextLen, got)
+			}
+			if !strings.Contains(got, "keyword") {
+				t.Fatalf("contextLen %d: match missing from %q", contextLen, got)
+			}
+		}
+	}
+
+	if got := extractContext("日本語keyword😀x", 9, 16, 2); got != "...語keyword😀..." {
+		t.Errorf("extractContext() = %q, want the window widened to whole runes", got)
+	}
+}
+
+func TestExtractWordContext(t *testing.T) {
+	tests := []struct {
+		name       string
+		line       string
+		contextLen int
+		want       string
+	}{
+		{"widens to whole words", "the quick brown keyword jumps over the lazy dog", 5, "...brown keyword jumps..."},
+		{"reaches line edges", "a keyword b", 1, "a keyword b"},
+		{"no spaces keeps rune edges", "日本語日本語日本語keyword日本語日本語日本語", 4, "...本語keyword日本..."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := strings.Index(tt.line, "keyword")
+			got := extractWordContext(tt.line, start, start+len("keyword"), tt.contextLen)
+			if got != tt.want {
+				t.Errorf("extractWordContext() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestStripCodeBlocksForTerminology(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -400,6 +446,105 @@ func TestStripCodeBlocksForTerminology_PreservesLineNumbers(t *testing.T) {
 	}
 }
 
+func TestGlossaryTermFromCellsCompilesMatcher(t *testing.T) {
+	literal, _ := glossaryTermFromCells([]string{"Wi.Fi", "Wi-Fi"})
+	if literal.matcher == nil || !literal.matcher.MatchString("wi.fi") || literal.matcher.MatchString("WiXFi") {
+		t.Error("literal term should match case-insensitively with metacharacters quoted")
+	}
+	broken, _ := glossaryTermFromCells([]string{"broken", "fixed", "(unclosed"})
+	if broken.matcher != nil {
+		t.Error("invalid pattern should yield a nil matcher")
+	}
+	custom, _ := glossaryTermFromCells([]string{"e-mail", "email", `e-?mail`})
+	if custom.matcher == nil || !custom.matcher.MatchString("E-Mail") {
+		t.Error("custom pattern should be used when set")
+	}
+}
+
+func TestFindTermIssues_MaxIssuesPerPage(t *testing.T) {
+	glossary := parseWikiTableGlossary("{| class=\"wikitable\"\n|-\n| colour || color\n|}")
+	content := strings.Repeat("colour colour\n", 10)
+
+	issues, total := findTermIssues(content, glossary, 5)
+	if len(issues) != 5 || total != 20 {
+		t.Errorf("got %d issues of %d, want 5 listed of 20", len(issues), total)
+	}
+	if issues[4].Line != 3 {
+		t.Errorf("listed issues should be the first found, last is on line %d", issues[4].Line)
+	}
+
+	issues, total = findTermIssues(content, glossary, 0)
+	if len(issues) != 20 || total != 20 {
+		t.Errorf("uncapped: got %d issues of %d, want 20", len(issues), total)
+	}
+}
+
+// terminologyBenchGlossary builds a 50-term glossary table and a 100-line
+// page that mentions some of the terms.
+func terminologyBenchGlossary() ([]GlossaryTerm, string) {
+	var table strings.Builder
+	table.WriteString("{| class=\"wikitable mcp-glossary\"\n! Incorrect !! Correct !! Pattern\n")
+	for i := 0; i < 50; i++ {
+		if i%5 == 0 {
+			fmt.Fprintf(&table, "|-\n| prod%d || Product%d || prod-?%d\\b\n", i, i, i)
+		} else {
+			fmt.Fprintf(&table, "|-\n| term%d || Term-%d\n", i, i)
+		}
+	}
+	table.WriteString("|}")
+
+	var page strings.Builder
+	for i := 0; i < 100; i++ {
+		fmt.Fprintf(&page, "Line %d mentions term%d, prod-%d and some filler text to scan.\n", i, i%50, (i*5)%50)
+	}
+	return parseWikiTableGlossary(table.String()), page.String()
+}
+
+// findTermIssuesCompilingPerLine is the previous approach, compiling every
+// term's regex for each line. It pins findTermIssues' output.
+func findTermIssuesCompilingPerLine(content string, glossary []GlossaryTerm) []TerminologyIssue {
+	issues := make([]TerminologyIssue, 0)
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, term := range glossary {
+			term.matcher = compileTermMatcher(term)
+			if term.matcher == nil {
+				continue
+			}
+			issues = append(issues, findTermIssuesInLine(line, lineNum, term)...)
+		}
+	}
+	return issues
+}
+
+func TestFindTermIssuesMatchesPerLineCompilation(t *testing.T) {
+	glossary, page := terminologyBenchGlossary()
+	if len(glossary) != 50 {
+		t.Fatalf("glossary has %d terms, want 50", len(glossary))
+	}
+	got, total := findTermIssues(page, glossary, 0)
+	want := findTermIssuesCompilingPerLine(page, glossary)
+	if len(got) == 0 || total != len(got) {
+		t.Fatalf("got %d issues with total %d, want a non-empty uncapped list", len(got), total)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("precompiled matchers found %d issues, per-line compilation %d", len(got), len(want))
+	}
+}
+
+func BenchmarkFindTermIssues(b *testing.B) {
+	glossary, page := terminologyBenchGlossary()
+	b.Run("precompiled", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			findTermIssues(page, glossary, 0)
+		}
+	})
+	b.Run("compile_per_line", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			findTermIssuesCompilingPerLine(page, glossary)
+		}
+	})
+}
+
 // Helper functions
 
 func containsString(s, substr string) bool {
@@ -950,6 +1095,61 @@ func TestHealthAudit_AnonymousAccess(t *testing.T) {
 	_ = result
 }
 
+func TestHealthAudit_ExplainOnly(t *testing.T) {
+	var requests []string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.FormValue("list")+r.FormValue("prop"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("list") != "categorymembers" {
+			t.Errorf("explain mode made a check request: %v", r.Form)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{"categorymembers": []interface{}{
+				map[string]interface{}{"pageid": float64(1), "ns": float64(0), "title": "Alpha"},
+				map[string]interface{}{"pageid": float64(2), "ns": float64(0), "title": "Beta"},
+				map[string]interface{}{"pageid": float64(3), "ns": float64(0), "title": "Gamma"},
+			}},
+		})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.HealthAudit(context.Background(), WikiHealthAuditArgs{
+		Category:    "Docs",
+		Checks:      []string{"links", "activity", "spelling"},
+		ExplainOnly: true,
+	})
+	if err != nil {
+		t.Fatalf("HealthAudit: %v", err)
+	}
+	plan := result.Plan
+	if plan == nil {
+		t.Fatal("expected a plan")
+	}
+	if plan.PageCount != 3 || !reflect.DeepEqual(plan.Pages, []string{"Alpha", "Beta", "Gamma"}) {
+		t.Errorf("plan pages = %v (%d), want the 3 category members", plan.Pages, plan.PageCount)
+	}
+	if len(plan.Checks) != 2 || plan.Checks[0].Name != "links" || plan.Checks[1].Name != "activity" {
+		t.Fatalf("planned checks = %+v, want links and activity", plan.Checks)
+	}
+	if plan.Checks[1].Scope != "wiki" || plan.Checks[0].EstimatedAPICalls != 5 {
+		t.Errorf("planned checks = %+v, want links over 3 pages (5 calls) and wiki-wide activity", plan.Checks)
+	}
+	if !reflect.DeepEqual(plan.UnknownChecks, []string{"spelling"}) {
+		t.Errorf("unknown checks = %v, want [spelling]", plan.UnknownChecks)
+	}
+	if plan.EstimatedAPICalls != 6 {
+		t.Errorf("estimated API calls = %d, want 6", plan.EstimatedAPICalls)
+	}
+	if result.BrokenLinks != nil || result.RecentActivity != nil || result.HealthScore != 0 {
+		t.Errorf("explain mode ran checks: %+v", result)
+	}
+	if len(requests) != 1 {
+		t.Errorf("requests = %v, want only the category listing", requests)
+	}
+}
+
 func TestCheckTerminology_WithCategory(t *testing.T) {
 	client := createTestClient(t)
 	defer client.Close()
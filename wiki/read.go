@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 // GetPage retrieves page content
@@ -19,22 +21,34 @@ func (c *Client) GetPage(ctx context.Context, args GetPageArgs) (PageContent, er
 	// and to avoid duplicate API calls for "Module overview" vs "Module Overview"
 	normalizedTitle := normalizePageTitle(args.Title)
 
-	// Check cache with normalized title
-	cacheKey := fmt.Sprintf("page_content:%s", normalizedTitle)
-	if cached, ok := c.getCached(cacheKey); ok {
-		return cached.(PageContent), nil
-	}
-
 	format := args.Format
 	if format == "" {
 		format = "wikitext"
+		if args.Variant != "" {
+			// Variants are converted when the page is rendered
+			format = "html"
+		}
+	}
+	if args.Variant != "" && format != "html" {
+		return PageContent{}, &ValidationError{
+			Field:      "variant",
+			Value:      args.Variant,
+			Message:    "language variants apply to rendered HTML, not to the stored wikitext",
+			Suggestion: "Set format to 'html' (or omit it) to get the page converted to the variant",
+		}
+	}
+
+	// Check cache with normalized title
+	cacheKey := pageContentCacheKey(normalizedTitle, args.Variant)
+	if cached, ok := c.getCached(cacheKey); ok {
+		return cached.(PageContent), nil
 	}
 
 	var result PageContent
 	var err error
 
 	if format == "html" {
-		result, err = c.getPageHTML(ctx, normalizedTitle)
+		result, err = c.getPageHTML(ctx, normalizedTitle, args.Variant)
 	} else {
 		result, err = c.getPageWikitext(ctx, normalizedTitle)
 	}
@@ -48,19 +62,38 @@ func (c *Client) GetPage(ctx context.Context, args GetPageArgs) (PageContent, er
 
 	// Also cache under the original title if different (for future lookups)
 	if args.Title != normalizedTitle {
-		originalCacheKey := fmt.Sprintf("page_content:%s", args.Title)
+		originalCacheKey := pageContentCacheKey(args.Title, args.Variant)
 		c.setCache(originalCacheKey, result, "page_content")
 	}
 
 	return result, nil
 }
 
+// pageContentCacheKey returns the GetPage cache key for title, keeping each
+// language variant's converted content apart from the unconverted page.
+func pageContentCacheKey(title, variant string) string {
+	if variant == "" {
+		return fmt.Sprintf("page_content:%s", title)
+	}
+	return fmt.Sprintf("page_content:%s|variant:%s", title, variant)
+}
+
 func (c *Client) getPageWikitext(ctx context.Context, title string) (PageContent, error) {
 	// Ensure logged in for wikis requiring auth for read
 	if err := c.EnsureLoggedIn(ctx); err != nil {
 		return PageContent{}, fmt.Errorf("authentication required: %w (configure MEDIAWIKI_USERNAME and MEDIAWIKI_PASSWORD)", err)
 	}
 
+	page, pageID, err := c.queryWikitextPage(ctx, title)
+	if err != nil {
+		return PageContent{}, err
+	}
+	return buildWikitextPageContent(page, pageID, title)
+}
+
+// queryWikitextPage fetches title's current revision with its content and
+// returns the page object and its ID, before any truncation.
+func (c *Client) queryWikitextPage(ctx context.Context, title string) (map[string]interface{}, string, error) {
 	params := url.Values{}
 	params.Set("action", "query")
 	params.Set("titles", title)
@@ -70,18 +103,18 @@ func (c *Client) getPageWikitext(ctx context.Context, title string) (PageContent
 
 	resp, err := c.apiRequest(ctx, params)
 	if err != nil {
-		return PageContent{}, fmt.Errorf("API request failed: %w", err)
+		return nil, "", fmt.Errorf("API request failed: %w", err)
 	}
 
 	// Safely extract query object
 	query, ok := resp["query"].(map[string]interface{})
 	if !ok {
-		return PageContent{}, fmt.Errorf("unexpected API response: missing 'query' object. This may indicate authentication is required for reading pages")
+		return nil, "", fmt.Errorf("unexpected API response: missing 'query' object. This may indicate authentication is required for reading pages")
 	}
 
 	pages, ok := query["pages"].(map[string]interface{})
 	if !ok {
-		return PageContent{}, fmt.Errorf("unexpected API response: missing 'pages' object")
+		return nil, "", fmt.Errorf("unexpected API response: missing 'pages' object")
 	}
 
 	for pageID, pageData := range pages {
@@ -89,10 +122,10 @@ func (c *Client) getPageWikitext(ctx context.Context, title string) (PageContent
 		if !ok {
 			continue
 		}
-		return buildWikitextPageContent(page, pageID, title)
+		return page, pageID, nil
 	}
 
-	return PageContent{}, fmt.Errorf("page '%s' not found in API response", title)
+	return nil, "", fmt.Errorf("page '%s' not found in API response", title)
 }
 
 // buildWikitextPageContent converts a single wikitext page object into a
@@ -170,7 +203,9 @@ func extractWikitextRevision(page map[string]interface{}, title string) (content
 	return content, rev, nil
 }
 
-func (c *Client) getPageHTML(ctx context.Context, title string) (PageContent, error) {
+// getPageHTML renders title with action=parse. A non-empty variant is
+// passed through so LanguageConverter wikis return the page in that script.
+func (c *Client) getPageHTML(ctx context.Context, title, variant string) (PageContent, error) {
 	// Ensure logged in for wikis requiring auth for read
 	if err := c.EnsureLoggedIn(ctx); err != nil {
 		return PageContent{}, fmt.Errorf("authentication required: %w (configure MEDIAWIKI_USERNAME and MEDIAWIKI_PASSWORD)", err)
@@ -180,6 +215,9 @@ func (c *Client) getPageHTML(ctx context.Context, title string) (PageContent, er
 	params.Set("action", "parse")
 	params.Set("page", title)
 	params.Set("prop", "text|revid")
+	if variant != "" {
+		params.Set("variant", variant)
+	}
 
 	resp, err := c.apiRequest(ctx, params)
 	if err != nil {
@@ -214,6 +252,7 @@ func (c *Client) getPageHTML(ctx context.Context, title string) (PageContent, er
 		PageID:    intField(parse, "pageid"),
 		Content:   content,
 		Format:    "html",
+		Variant:   variant,
 		Revision:  intField(parse, "revid"),
 		Truncated: truncated,
 	}
@@ -240,11 +279,33 @@ func intField(m map[string]interface{}, key string) int {
 	return 0
 }
 
-// Parse parses wikitext and returns HTML
+// defaultParseProps are the parse props returned when ParseArgs.Props is empty.
+var defaultParseProps = []string{"text", "categories", "links"}
+
+// validParseProps is the set of parse props callers may request.
+var validParseProps = map[string]bool{
+	"text":       true,
+	"categories": true,
+	"links":      true,
+	"sections":   true,
+	"wikitext":   true,
+	"headhtml":   true,
+}
+
+// Parse parses wikitext (or a page's current revision) and returns HTML
+// plus the requested metadata. Setting Section limits the output to one
+// section, with heading anchors matching those on the full page.
 func (c *Client) Parse(ctx context.Context, args ParseArgs) (ParseResult, error) {
-	if args.Wikitext == "" {
+	if args.Wikitext == "" && args.Title == "" {
 		return ParseResult{}, fmt.Errorf("wikitext is required")
 	}
+	props, err := resolveParseProps(args.Props)
+	if err != nil {
+		return ParseResult{}, err
+	}
+	if args.Section != nil && *args.Section < 0 {
+		return ParseResult{}, fmt.Errorf("section must be 0 or greater, got %d", *args.Section)
+	}
 
 	// Ensure logged in for wikis requiring auth for read
 	if err := c.EnsureLoggedIn(ctx); err != nil {
@@ -253,12 +314,18 @@ func (c *Client) Parse(ctx context.Context, args ParseArgs) (ParseResult, error)
 
 	params := url.Values{}
 	params.Set("action", "parse")
-	params.Set("text", args.Wikitext)
-	params.Set("contentmodel", "wikitext")
-	params.Set("prop", "text|categories|links")
-
-	if args.Title != "" {
-		params.Set("title", args.Title)
+	if args.Wikitext != "" {
+		params.Set("text", args.Wikitext)
+		params.Set("contentmodel", "wikitext")
+		if args.Title != "" {
+			params.Set("title", args.Title)
+		}
+	} else {
+		params.Set("page", normalizePageTitle(args.Title))
+	}
+	params.Set("prop", strings.Join(append(props, "displaytitle"), "|"))
+	if args.Section != nil {
+		params.Set("section", strconv.Itoa(*args.Section))
 	}
 
 	resp, err := c.apiRequest(ctx, params)
@@ -270,32 +337,56 @@ func (c *Client) Parse(ctx context.Context, args ParseArgs) (ParseResult, error)
 	if !ok {
 		return ParseResult{}, fmt.Errorf("unexpected API response: missing 'parse' object")
 	}
-	text, ok := parse["text"].(map[string]interface{})
-	if !ok {
-		return ParseResult{}, fmt.Errorf("unexpected API response: missing 'text' object")
-	}
-	htmlContent := getString(text["*"])
-
-	// Sanitize HTML to prevent XSS
-	htmlContent = sanitizeHTML(htmlContent)
 
-	truncated := false
-	if len(htmlContent) > CharacterLimit {
-		htmlContent, truncated = truncateContent(htmlContent, CharacterLimit)
+	result := ParseResult{
+		DisplayTitle: stripHTMLTags(getString(parse["displaytitle"])),
+		Categories:   extractStarValues(parse["categories"]),
+		Links:        extractStarValues(parse["links"]),
+		Sections:     parseSectionInfos(parse["sections"]),
+	}
+	if wikitext, ok := parse["wikitext"].(map[string]interface{}); ok {
+		result.Wikitext = getString(wikitext["*"])
+	}
+	if headHTML, ok := parse["headhtml"].(map[string]interface{}); ok {
+		result.HeadHTML = sanitizeHTML(getString(headHTML["*"]))
 	}
 
-	result := ParseResult{
-		HTML:       htmlContent,
-		Truncated:  truncated,
-		Categories: extractStarValues(parse["categories"]),
-		Links:      extractStarValues(parse["links"]),
+	if slices.Contains(props, "text") {
+		text, ok := parse["text"].(map[string]interface{})
+		if !ok {
+			return ParseResult{}, fmt.Errorf("unexpected API response: missing 'text' object")
+		}
+		// Sanitize HTML to prevent XSS
+		result.HTML = sanitizeHTML(getString(text["*"]))
+		if len(result.HTML) > CharacterLimit {
+			result.HTML, result.Truncated = truncateContent(result.HTML, CharacterLimit)
+		}
 	}
-	if truncated {
+	if result.Truncated {
 		result.Message = "Content was truncated due to size limits."
 	}
 	return result, nil
 }
 
+// resolveParseProps validates requested parse props, falling back to
+// defaultParseProps when none are given.
+func resolveParseProps(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return append([]string(nil), defaultParseProps...), nil
+	}
+	props := make([]string, 0, len(requested))
+	for _, p := range requested {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if !validParseProps[p] {
+			return nil, fmt.Errorf("unsupported parse prop '%s' (valid: text, categories, links, sections, wikitext, headhtml)", p)
+		}
+		if !slices.Contains(props, p) {
+			props = append(props, p)
+		}
+	}
+	return props, nil
+}
+
 // extractStarValues pulls the "*" string field from each map entry in a
 // MediaWiki list (used for categories and links in parse responses).
 func extractStarValues(raw interface{}) []string {
@@ -312,6 +403,76 @@ func extractStarValues(raw interface{}) []string {
 	return values
 }
 
+// PreviewEdit renders content as it would appear once saved to title,
+// without saving anything. The pre-save transform is applied, so signatures
+// and subst: expand as they would on save, and parser warnings (template
+// loops, exceeded expansion limits, ...) are returned alongside the HTML.
+func (c *Client) PreviewEdit(ctx context.Context, args PreviewEditArgs) (PreviewEditResult, error) {
+	if args.Title == "" {
+		return PreviewEditResult{}, fmt.Errorf("title is required")
+	}
+	if args.Content == "" {
+		return PreviewEditResult{}, fmt.Errorf("content is required")
+	}
+	if err := ValidateContentSize(args.Content, args.Title, MaxEditSize); err != nil {
+		return PreviewEditResult{}, err
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return PreviewEditResult{}, err
+	}
+
+	params := url.Values{}
+	params.Set("action", "parse")
+	params.Set("title", normalizePageTitle(args.Title))
+	params.Set("text", args.Content)
+	params.Set("contentmodel", "wikitext")
+	params.Set("prop", "text|categories|parsewarnings|displaytitle")
+	params.Set("pst", "1")
+	params.Set("preview", "1")
+	params.Set("disableeditsection", "1")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return PreviewEditResult{}, err
+	}
+
+	parse, ok := resp["parse"].(map[string]interface{})
+	if !ok {
+		return PreviewEditResult{}, fmt.Errorf("unexpected API response: missing 'parse' object")
+	}
+
+	result := PreviewEditResult{
+		Title:        htmlPageTitle(parse, args.Title),
+		DisplayTitle: stripHTMLTags(getString(parse["displaytitle"])),
+		HTML:         sanitizeHTML(getString(getMap(parse["text"])["*"])),
+		Categories:   extractStarValues(parse["categories"]),
+		Warnings:     parseWarningTexts(parse["parsewarnings"]),
+	}
+	if len(result.HTML) > CharacterLimit {
+		result.HTML, result.Truncated = truncateContent(result.HTML, CharacterLimit)
+		result.Message = "Content was truncated due to size limits."
+	}
+	return result, nil
+}
+
+// parseWarningTexts reads the parsewarnings list of a parse response, whose
+// entries are plain strings or {"*": text} objects depending on the API's
+// formatversion.
+func parseWarningTexts(raw interface{}) []string {
+	var warnings []string
+	for _, w := range getSlice(raw) {
+		text, ok := w.(string)
+		if !ok {
+			text = getString(getMap(w)["*"])
+		}
+		if text != "" {
+			warnings = append(warnings, stripHTMLTags(text))
+		}
+	}
+	return warnings
+}
+
 // GetPageSummary returns the lead section and key metadata for a page.
 // This is much lighter than GetPage for large pages when you only need an overview.
 func (c *Client) GetPageSummary(ctx context.Context, args GetPageSummaryArgs) (PageSummaryResult, error) {
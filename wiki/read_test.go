@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -105,6 +107,74 @@ func TestParse_WithCategoriesAndLinks(t *testing.T) {
 	}
 }
 
+func TestParse_SectionScoped(t *testing.T) {
+	var got url.Values
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("action") == "parse" {
+			got = r.Form
+			response := map[string]interface{}{
+				"parse": map[string]interface{}{
+					"title":        "Guide",
+					"pageid":       float64(3),
+					"displaytitle": `<span class="mw-page-title-main">Guide</span>`,
+					"text": map[string]interface{}{
+						"*": `<h2><span class="mw-headline" id="Install">Install</span></h2><p>Run it.</p>`,
+					},
+					"wikitext": map[string]interface{}{"*": "== Install ==\nRun it."},
+					"sections": []interface{}{
+						map[string]interface{}{"index": "2", "level": "2", "line": "Install", "anchor": "Install"},
+					},
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+		}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.Parse(context.Background(), ParseArgs{
+		Title:   "Guide",
+		Section: intPtr(2),
+		Props:   []string{"text", "sections", "wikitext"},
+	})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got.Get("page") != "Guide" || got.Get("text") != "" {
+		t.Errorf("expected page=Guide without text, got page=%q text=%q", got.Get("page"), got.Get("text"))
+	}
+	if got.Get("section") != "2" {
+		t.Errorf("section param = %q, want 2", got.Get("section"))
+	}
+	if got.Get("prop") != "text|sections|wikitext|displaytitle" {
+		t.Errorf("prop param = %q", got.Get("prop"))
+	}
+	if !strings.Contains(result.HTML, `id="Install"`) {
+		t.Errorf("HTML = %q, want section anchor", result.HTML)
+	}
+	if result.DisplayTitle != "Guide" {
+		t.Errorf("DisplayTitle = %q, want Guide", result.DisplayTitle)
+	}
+	if len(result.Sections) != 1 || result.Sections[0].Anchor != "Install" || result.Sections[0].Index != 2 {
+		t.Errorf("Sections = %+v", result.Sections)
+	}
+	if result.Wikitext != "== Install ==\nRun it." {
+		t.Errorf("Wikitext = %q", result.Wikitext)
+	}
+}
+
+func TestParse_InvalidProp(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	if _, err := client.Parse(context.Background(), ParseArgs{Wikitext: "x", Props: []string{"bogus"}}); err == nil {
+		t.Error("expected error for unsupported prop")
+	}
+}
+
 func TestGetPageHTML_Success(t *testing.T) {
 	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
 		_ = r.ParseForm()
@@ -191,3 +261,110 @@ func TestGetPage_HTMLFormat(t *testing.T) {
 		t.Errorf("Format = %q, want 'html'", result.Format)
 	}
 }
+
+func TestGetPage_Variant(t *testing.T) {
+	var gotVariants []string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.FormValue("action") != "parse" {
+			t.Errorf("unexpected request action=%q; a variant page must be rendered", r.FormValue("action"))
+			return
+		}
+		variant := r.FormValue("variant")
+		gotVariants = append(gotVariants, variant)
+		text := "<p>汉字</p>"
+		if variant == "zh-hant" {
+			text = "<p>漢字</p>"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"parse": map[string]interface{}{
+				"title":  "Test Page",
+				"pageid": float64(1),
+				"text":   map[string]interface{}{"*": text},
+			},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	ctx := context.Background()
+	hant, err := client.GetPage(ctx, GetPageArgs{Title: "Test Page", Variant: "zh-hant"})
+	if err != nil {
+		t.Fatalf("GetPage with variant failed: %v", err)
+	}
+	if hant.Format != "html" || hant.Variant != "zh-hant" || !strings.Contains(hant.Content, "漢字") {
+		t.Errorf("result = %+v, want zh-hant HTML", hant)
+	}
+
+	// The unconverted page must not be served from the variant's cache entry
+	plain, err := client.GetPage(ctx, GetPageArgs{Title: "Test Page", Format: "html"})
+	if err != nil {
+		t.Fatalf("GetPage without variant failed: %v", err)
+	}
+	if plain.Variant != "" || !strings.Contains(plain.Content, "汉字") {
+		t.Errorf("result = %+v, want the unconverted page", plain)
+	}
+	if len(gotVariants) != 2 || gotVariants[0] != "zh-hant" || gotVariants[1] != "" {
+		t.Errorf("variant params = %q, want [zh-hant, \"\"]", gotVariants)
+	}
+
+	if _, err := client.GetPage(ctx, GetPageArgs{Title: "Test Page", Format: "wikitext", Variant: "zh-hant"}); err == nil {
+		t.Error("expected an error for a variant with wikitext format")
+	}
+}
+
+func TestPreviewEdit(t *testing.T) {
+	var got url.Values
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.FormValue("action") == "edit" {
+			t.Error("PreviewEdit must not save the page")
+		}
+		got = r.Form
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"parse": map[string]interface{}{
+				"title":        "Draft Page",
+				"displaytitle": "Draft Page",
+				"text":         map[string]interface{}{"*": `<p>Hello <b>world</b></p><script>alert(1)</script>`},
+				"categories":   []interface{}{map[string]interface{}{"sortkey": "", "*": "Drafts"}},
+				"parsewarnings": []interface{}{
+					"Template loop detected: <a href=\"/wiki/Template:Loop\">Template:Loop</a>",
+				},
+			},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	content := "Hello '''world''' {{Loop}}\n[[Category:Drafts]]"
+	result, err := client.PreviewEdit(context.Background(), PreviewEditArgs{Title: "Draft Page", Content: content})
+	if err != nil {
+		t.Fatalf("PreviewEdit failed: %v", err)
+	}
+
+	if got.Get("action") != "parse" || got.Get("text") != content || got.Get("title") != "Draft Page" || got.Get("pst") != "1" {
+		t.Errorf("request = %v, want a pre-save-transformed parse of the content", got)
+	}
+	if !strings.Contains(got.Get("prop"), "parsewarnings") {
+		t.Errorf("prop = %q, want parsewarnings requested", got.Get("prop"))
+	}
+	if !strings.Contains(result.HTML, "<b>world</b>") || strings.Contains(result.HTML, "<script") {
+		t.Errorf("HTML = %q, want rendered and sanitized output", result.HTML)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0] != "Template loop detected: Template:Loop" {
+		t.Errorf("Warnings = %q, want the template loop warning as text", result.Warnings)
+	}
+	if len(result.Categories) != 1 || result.Categories[0] != "Drafts" {
+		t.Errorf("Categories = %v, want [Drafts]", result.Categories)
+	}
+
+	if _, err := client.PreviewEdit(context.Background(), PreviewEditArgs{Title: "Draft Page"}); err == nil {
+		t.Error("expected an error for empty content")
+	}
+}
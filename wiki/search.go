@@ -47,6 +47,9 @@ func (c *Client) Search(ctx context.Context, args SearchArgs) (SearchResult, err
 	if args.Offset > 0 {
 		params.Set("sroffset", strconv.Itoa(args.Offset))
 	}
+	if args.Namespace != nil {
+		params.Set("srnamespace", strconv.Itoa(*args.Namespace))
+	}
 
 	resp, err := c.apiRequest(ctx, params)
 	if err != nil {
@@ -64,7 +67,10 @@ func (c *Client) Search(ctx context.Context, args SearchArgs) (SearchResult, err
 		totalHits = getInt(searchInfo["totalhits"])
 	}
 
-	searchResults := getSlice(query["search"])
+	searchResults, ok := query["search"].([]interface{})
+	if !ok {
+		return SearchResult{}, fmt.Errorf("unexpected response format: missing search list")
+	}
 	results := make([]SearchHit, 0, len(searchResults))
 
 	for _, sr := range searchResults {
@@ -78,6 +84,9 @@ func (c *Client) Search(ctx context.Context, args SearchArgs) (SearchResult, err
 			Snippet: stripHTMLTags(getString(item["snippet"])),
 			Size:    getInt(item["size"]),
 		}
+		if args.ResolveSections && len(results) < maxSectionResolvedHits {
+			c.resolveHitSection(ctx, &hit, searchMatchTerms(getString(item["snippet"]), args.Query))
+		}
 		results = append(results, hit)
 	}
 
@@ -95,6 +104,80 @@ func (c *Client) Search(ctx context.Context, args SearchArgs) (SearchResult, err
 	return result, nil
 }
 
+// maxSectionResolvedHits caps how many hits per search get a section
+// lookup, since each one costs up to two extra API requests.
+const maxSectionResolvedHits = 10
+
+// searchMatchRegex captures the highlighted terms in a search snippet.
+var searchMatchRegex = regexp.MustCompile(`<span class="searchmatch">(.*?)</span>`)
+
+// searchMatchTerms returns the terms to look for in a hit's wikitext: the
+// words the search engine highlighted in the snippet, falling back to the
+// query words when the snippet has no highlights.
+func searchMatchTerms(rawSnippet, query string) []string {
+	var terms []string
+	for _, m := range searchMatchRegex.FindAllStringSubmatch(rawSnippet, -1) {
+		if term := stripHTMLTags(m[1]); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) == 0 {
+		terms = strings.Fields(strings.Trim(query, `"`))
+	}
+	return terms
+}
+
+// resolveHitSection sets the section title and anchor of the first place in
+// the hit's wikitext where one of terms appears. Failures leave the hit
+// unchanged: section info is a best-effort extra on top of the search.
+func (c *Client) resolveHitSection(ctx context.Context, hit *SearchHit, terms []string) {
+	page, err := c.GetPage(ctx, GetPageArgs{Title: hit.Title, Format: "wikitext"})
+	if err != nil {
+		return
+	}
+	offset := firstTermOffset(page.Content, terms)
+	if offset < 0 {
+		return
+	}
+	sections, err := c.GetSections(ctx, GetSectionsArgs{Title: hit.Title})
+	if err != nil {
+		return
+	}
+	if sec, ok := sectionAtOffset(sections.Sections, offset); ok {
+		hit.SectionTitle = sec.Title
+		hit.SectionAnchor = sec.Anchor
+	}
+}
+
+// firstTermOffset returns the byte offset of the earliest case-insensitive
+// occurrence of any term in content, or -1 if none occurs.
+func firstTermOffset(content string, terms []string) int {
+	lower := strings.ToLower(content)
+	first := -1
+	for _, term := range terms {
+		if i := strings.Index(lower, strings.ToLower(term)); i >= 0 && (first < 0 || i < first) {
+			first = i
+		}
+	}
+	return first
+}
+
+// sectionAtOffset returns the last section whose heading starts at or before
+// offset. ok is false when offset falls in the intro, before any heading.
+func sectionAtOffset(sections []SectionInfo, offset int) (SectionInfo, bool) {
+	var found SectionInfo
+	ok := false
+	for _, sec := range sections {
+		if sec.ByteOffset < 0 || sec.ByteOffset > offset {
+			continue
+		}
+		if !ok || sec.ByteOffset >= found.ByteOffset {
+			found, ok = sec, true
+		}
+	}
+	return found, ok
+}
+
 // SearchInPage searches for text within a specific wiki page
 // compileSearchRegex compiles the search query, either as a user regex or as
 // quoted literal text. It enforces a length cap on user regex input.
@@ -9,6 +9,102 @@ import (
 	"testing"
 )
 
+func TestSearch_ResolveSections(t *testing.T) {
+	const content = "Intro text.\n== Setup ==\nInstall it.\n== Troubleshooting ==\nIf the daemon crashes, restart it.\n"
+	var namespace string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("list") == "search":
+			namespace = r.FormValue("srnamespace")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"searchinfo": map[string]interface{}{"totalhits": float64(1)},
+					"search": []interface{}{
+						map[string]interface{}{
+							"pageid":  float64(7),
+							"title":   "Guide",
+							"snippet": `If the <span class="searchmatch">daemon</span> crashes`,
+							"size":    float64(len(content)),
+						},
+					},
+				},
+			})
+		case r.FormValue("action") == "query":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"7": map[string]interface{}{
+							"pageid": float64(7),
+							"title":  "Guide",
+							"revisions": []interface{}{
+								map[string]interface{}{
+									"slots": map[string]interface{}{
+										"main": map[string]interface{}{"content": content},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		case r.FormValue("action") == "parse":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"parse": map[string]interface{}{
+					"title":  "Guide",
+					"pageid": float64(7),
+					"sections": []interface{}{
+						map[string]interface{}{"index": "1", "level": "2", "line": "Setup", "anchor": "Setup", "byteoffset": float64(12)},
+						map[string]interface{}{"index": "2", "level": "2", "line": "Troubleshooting", "anchor": "Troubleshooting", "byteoffset": float64(36)},
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.Search(context.Background(), SearchArgs{
+		Query:           "daemon",
+		Namespace:       intPtr(0),
+		ResolveSections: true,
+	})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if namespace != "0" {
+		t.Errorf("srnamespace = %q, want 0", namespace)
+	}
+	if len(result.Results) != 1 {
+		t.Fatalf("got %d results, want 1", len(result.Results))
+	}
+	hit := result.Results[0]
+	if hit.SectionTitle != "Troubleshooting" || hit.SectionAnchor != "Troubleshooting" {
+		t.Errorf("section = %q#%q, want Troubleshooting", hit.SectionTitle, hit.SectionAnchor)
+	}
+}
+
+func TestSectionAtOffset(t *testing.T) {
+	sections := []SectionInfo{
+		{Title: "A", ByteOffset: 10},
+		{Title: "From template", ByteOffset: -1},
+		{Title: "B", ByteOffset: 50},
+	}
+	if _, ok := sectionAtOffset(sections, 5); ok {
+		t.Error("offset in the intro should not resolve to a section")
+	}
+	if sec, _ := sectionAtOffset(sections, 30); sec.Title != "A" {
+		t.Errorf("offset 30 resolved to %q, want A", sec.Title)
+	}
+	if sec, _ := sectionAtOffset(sections, 50); sec.Title != "B" {
+		t.Errorf("offset 50 resolved to %q, want B", sec.Title)
+	}
+}
+
 func TestSearchInPage_Success(t *testing.T) {
 	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
 		_ = r.ParseForm()
@@ -3,7 +3,9 @@ package wiki
 import (
 	"context"
 	"fmt"
+	"html"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -52,9 +54,28 @@ func (c *Client) GetSections(ctx context.Context, args GetSectionsArgs) (GetSect
 	pageID := getInt(parse["pageid"])
 	title := getString(parse["title"])
 
-	sectionsRaw, _ := parse["sections"].([]interface{})
-	sections := make([]SectionInfo, 0, len(sectionsRaw))
+	sections := parseSectionInfos(parse["sections"])
+
+	result := GetSectionsResult{
+		Title:    title,
+		PageID:   pageID,
+		Sections: sections,
+		Message:  fmt.Sprintf("Found %d sections. Use section parameter to get specific section content.", len(sections)),
+	}
 
+	c.setCache(cacheKey, result, "page_content")
+	return result, nil
+}
+
+// parseSectionInfos converts the "sections" list of a parse response into
+// SectionInfo values. It returns nil when the list is absent.
+func parseSectionInfos(raw interface{}) []SectionInfo {
+	sectionsRaw, ok := raw.([]interface{})
+	if !ok {
+		return nil
+	}
+	sections := make([]SectionInfo, 0, len(sectionsRaw))
+	anchors := sectionAnchorSet{}
 	for _, s := range sectionsRaw {
 		sec, ok := s.(map[string]interface{})
 		if !ok {
@@ -66,25 +87,76 @@ func (c *Client) GetSections(ctx context.Context, args GetSectionsArgs) (GetSect
 		if line, ok := sec["line"].(float64); ok {
 			lineNum = int(line)
 		}
+		byteOffset := -1
+		if offset, ok := sec["byteoffset"].(float64); ok {
+			byteOffset = int(offset)
+		}
+
+		title := stripHTMLTags(getString(sec["line"]))
+		// Older MediaWiki versions omit the anchor; derive it the same way
+		// the parser does. The set is updated either way so duplicate
+		// numbering stays in step with the API's.
+		anchor := anchors.next(title)
+		if apiAnchor := getString(sec["anchor"]); apiAnchor != "" {
+			anchor = apiAnchor
+		}
 
 		sections = append(sections, SectionInfo{
-			Index:   index,
-			Level:   level,
-			Title:   stripHTMLTags(getString(sec["line"])),
-			Anchor:  getString(sec["anchor"]),
-			LineNum: lineNum,
+			Index:      index,
+			Level:      level,
+			Title:      title,
+			Anchor:     anchor,
+			LineNum:    lineNum,
+			ByteOffset: byteOffset,
 		})
 	}
+	return sections
+}
 
-	result := GetSectionsResult{
-		Title:    title,
-		PageID:   pageID,
-		Sections: sections,
-		Message:  fmt.Sprintf("Found %d sections. Use section parameter to get specific section content.", len(sections)),
-	}
+// sectionWhitespaceRegex matches the runs of spaces and underscores that
+// MediaWiki collapses in heading text before building an anchor.
+var sectionWhitespaceRegex = regexp.MustCompile(`[ _\t\n\r]+`)
+
+// percentEscapeRegex matches a literal "%XX" sequence in heading text, which
+// must be escaped so a browser does not decode it in the link fragment.
+var percentEscapeRegex = regexp.MustCompile(`%([0-9A-Fa-f]{2})`)
+
+// SectionAnchor returns the fragment MediaWiki links a heading with, as in
+// [[Page#Section]] deep links, using the parser's HTML5 anchor encoding:
+// entities are decoded, whitespace and underscores collapse to a single
+// underscore, "%XX" sequences are escaped to "%25XX", and other characters
+// (including non-ASCII) are kept. occurrence is the heading's 1-based rank
+// among headings with the same anchor on the page; the second and later get
+// a "_2", "_3", ... suffix.
+func SectionAnchor(heading string, occurrence int) string {
+	text := html.UnescapeString(heading)
+	text = strings.TrimSpace(sectionWhitespaceRegex.ReplaceAllString(text, " "))
+	anchor := strings.ReplaceAll(text, " ", "_")
+	anchor = percentEscapeRegex.ReplaceAllString(anchor, "%25$1")
+	if occurrence > 1 {
+		anchor += "_" + strconv.Itoa(occurrence)
+	}
+	return anchor
+}
 
-	c.setCache(cacheKey, result, "page_content")
-	return result, nil
+// sectionAnchorSet assigns anchors to a page's headings in order, the way
+// the parser does: anchors are compared case-insensitively, and a heading
+// whose anchor is taken gets the first free "_2", "_3", ... suffix.
+type sectionAnchorSet map[string]bool
+
+// next returns the anchor for the page's next heading and reserves it.
+func (s sectionAnchorSet) next(heading string) string {
+	key := strings.ToLower(SectionAnchor(heading, 1))
+	if !s[key] {
+		s[key] = true
+		return SectionAnchor(heading, 1)
+	}
+	n := 2
+	for s[key+"_"+strconv.Itoa(n)] {
+		n++
+	}
+	s[key+"_"+strconv.Itoa(n)] = true
+	return SectionAnchor(heading, n)
 }
 
 // getSectionContent retrieves the content of a specific section
@@ -146,6 +218,7 @@ func (c *Client) getSectionContent(ctx context.Context, title string, section in
 		PageID:         pageID,
 		SectionContent: content,
 		SectionTitle:   sectionTitle,
+		Revision:       getInt(parse["revid"]),
 		Format:         format,
 	}, nil
 }
@@ -102,3 +102,62 @@ func TestGetSections_WithSection(t *testing.T) {
 		t.Error("Expected section content, got empty")
 	}
 }
+
+func TestSectionAnchor(t *testing.T) {
+	// Expected values are the anchors MediaWiki (HTML5 fragment mode)
+	// produces for the same headings.
+	tests := []struct {
+		name       string
+		heading    string
+		occurrence int
+		want       string
+	}{
+		{"spaces", "Getting started", 1, "Getting_started"},
+		{"collapsed whitespace", "  Install   the _ client ", 1, "Install_the_client"},
+		{"non-ASCII kept", "Références et liens", 1, "Références_et_liens"},
+		{"CJK kept", "安装 指南", 1, "安装_指南"},
+		{"punctuation kept", `C++ & C#: "quick" start?`, 1, `C++_&_C#:_"quick"_start?`},
+		{"entities decoded", "Q&amp;A &lt;beta&gt;", 1, "Q&A_<beta>"},
+		{"literal percent", "100% done", 1, "100%_done"},
+		{"percent escape sequence", "Encode %20 and %C3%A9", 1, "Encode_%2520_and_%25C3%25A9"},
+		{"first occurrence", "Notes", 1, "Notes"},
+		{"zero occurrence", "Notes", 0, "Notes"},
+		{"duplicate", "Notes", 2, "Notes_2"},
+		{"third duplicate", "See also", 3, "See_also_3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SectionAnchor(tt.heading, tt.occurrence); got != tt.want {
+				t.Errorf("SectionAnchor(%q, %d) = %q, want %q", tt.heading, tt.occurrence, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSectionAnchorSet(t *testing.T) {
+	// MediaWiki numbers duplicates case-insensitively and skips suffixes
+	// already taken by a literal heading such as "Notes 2".
+	headings := []string{"Notes", "Notes", "notes", "Notes 2", "Notes", "Summary"}
+	want := []string{"Notes", "Notes_2", "notes_3", "Notes_2_2", "Notes_4", "Summary"}
+
+	anchors := sectionAnchorSet{}
+	for i, heading := range headings {
+		if got := anchors.next(heading); got != want[i] {
+			t.Errorf("heading %d (%q) anchor = %q, want %q", i, heading, got, want[i])
+		}
+	}
+}
+
+func TestParseSectionInfos_DerivesMissingAnchors(t *testing.T) {
+	sections := parseSectionInfos([]interface{}{
+		map[string]interface{}{"level": "2", "index": "1", "line": "<i>Setup</i> guide"},
+		map[string]interface{}{"level": "2", "index": "2", "line": "Setup guide"},
+		map[string]interface{}{"level": "2", "index": "3", "line": "Custom", "anchor": "Custom_anchor"},
+	})
+	want := []string{"Setup_guide", "Setup_guide_2", "Custom_anchor"}
+	for i, sec := range sections {
+		if sec.Anchor != want[i] {
+			t.Errorf("section %d anchor = %q, want %q", i+1, sec.Anchor, want[i])
+		}
+	}
+}
@@ -103,6 +103,7 @@ func (c *Client) RestoreSession(s SessionState) error {
 	if s.LoggedIn && time.Now().Before(s.TokenExpiry) {
 		c.mu.Lock()
 		c.loggedIn = true
+		c.csrfToken = "" // belongs to whatever session was live before
 		c.tokenExpiry = s.TokenExpiry
 		c.mu.Unlock()
 	}
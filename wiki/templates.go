@@ -0,0 +1,202 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ExpandTemplates returns wikitext with every template, parser function and
+// magic word expanded server-side. Either raw wikitext or a page title (whose
+// current wikitext is fetched first, in full rather than cut at
+// CharacterLimit like GetPage) must be given.
+func (c *Client) ExpandTemplates(ctx context.Context, args ExpandTemplatesArgs) (ExpandTemplatesResult, error) {
+	if args.Wikitext == "" && args.Title == "" {
+		return ExpandTemplatesResult{}, fmt.Errorf("either 'title' or 'wikitext' must be specified")
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return ExpandTemplatesResult{}, err
+	}
+
+	wikitext := args.Wikitext
+	title := args.Title
+	if wikitext == "" {
+		var err error
+		if wikitext, title, err = c.fullPageWikitext(ctx, args.Title); err != nil {
+			return ExpandTemplatesResult{}, err
+		}
+	}
+
+	expanded, err := c.expandWikitext(ctx, wikitext, title)
+	if err != nil {
+		return ExpandTemplatesResult{}, err
+	}
+
+	result := ExpandTemplatesResult{Title: title, Wikitext: expanded}
+	if len(expanded) > CharacterLimit {
+		result.Wikitext, result.Truncated = truncateContent(expanded, CharacterLimit)
+		result.Message = "Content was truncated due to size limits."
+	}
+	return result, nil
+}
+
+// fullPageWikitext returns title's current wikitext and canonical title.
+// Unlike GetPage it never truncates, so a large page is expanded whole
+// instead of as a cut-off page followed by the truncation notice.
+func (c *Client) fullPageWikitext(ctx context.Context, title string) (wikitext, pageTitle string, err error) {
+	page, _, err := c.queryWikitextPage(ctx, title)
+	if err != nil {
+		return "", "", err
+	}
+	if _, missing := page["missing"]; missing {
+		return "", "", NewPageNotFoundError(title)
+	}
+	wikitext, _, err = extractWikitextRevision(page, title)
+	if err != nil {
+		return "", "", err
+	}
+	pageTitle = getString(page["title"])
+	if pageTitle == "" {
+		pageTitle = title
+	}
+	return wikitext, pageTitle, nil
+}
+
+// templateTitle returns the full page title for a template name given with
+// or without the "Template:" prefix.
+func templateTitle(name string) string {
+	name = strings.TrimSpace(name)
+	if len(name) >= len("Template:") && strings.EqualFold(name[:len("Template:")], "Template:") {
+		name = strings.TrimSpace(name[len("Template:"):])
+	}
+	return "Template:" + name
+}
+
+// expandWikitext runs wikitext through action=expandtemplates and returns
+// the fully expanded wikitext. title sets the page context for magic words
+// such as {{PAGENAME}}; it may be empty.
+func (c *Client) expandWikitext(ctx context.Context, wikitext, title string) (string, error) {
+	params := url.Values{}
+	params.Set("action", "expandtemplates")
+	params.Set("text", wikitext)
+	params.Set("prop", "wikitext")
+	if title != "" {
+		params.Set("title", title)
+	}
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	expanded, ok := resp["expandtemplates"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("unexpected API response: missing 'expandtemplates' object")
+	}
+	return getString(expanded["wikitext"]), nil
+}
+
+const (
+	// maxTemplateUsageScan caps how many templates GetTemplateUsageStats
+	// counts in one call; each template costs at least one API request.
+	maxTemplateUsageScan = 200
+	// maxTemplateUsageCount stops counting a single template's transclusions
+	// after this many, so a handful of ubiquitous templates cannot turn the
+	// report into thousands of requests.
+	maxTemplateUsageCount = 5000
+)
+
+// GetTemplateUsageStats enumerates templates and reports how many pages
+// transclude each, most used first. Templates with zero uses are included,
+// since they are the usual deprecation candidates.
+func (c *Client) GetTemplateUsageStats(ctx context.Context, args TemplateUsageStatsArgs) (TemplateUsageStatsResult, error) {
+	return c.templateUsageStats(ctx, "", args.Namespace, args.Limit)
+}
+
+// infoboxTemplatePrefix is the conventional name prefix of infobox templates.
+const infoboxTemplatePrefix = "Infobox"
+
+// GetInfoboxTemplates lists the templates named "Infobox..." with their
+// transclusion counts, most used first, so callers know which infoboxes a
+// wiki actually uses.
+func (c *Client) GetInfoboxTemplates(ctx context.Context, args InfoboxTemplatesArgs) (TemplateUsageStatsResult, error) {
+	return c.templateUsageStats(ctx, infoboxTemplatePrefix, args.Namespace, args.Limit)
+}
+
+// templateUsageStats counts transclusions for the templates whose names
+// (without the namespace) start with prefix, or all templates when prefix
+// is empty.
+func (c *Client) templateUsageStats(ctx context.Context, prefix string, namespace *int, limit int) (TemplateUsageStatsResult, error) {
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return TemplateUsageStatsResult{}, err
+	}
+
+	limit = normalizeLimit(limit, DefaultLimit, maxTemplateUsageScan)
+	list, err := c.ListPages(ctx, ListPagesArgs{Prefix: prefix, Namespace: 10, Limit: limit})
+	if err != nil {
+		return TemplateUsageStatsResult{}, err
+	}
+
+	result := TemplateUsageStatsResult{
+		Templates: make([]TemplateUsage, 0, len(list.Pages)),
+		HasMore:   list.HasMore,
+	}
+	for _, page := range list.Pages {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		usage := TemplateUsage{Title: page.Title}
+		usage.UsageCount, usage.Capped, err = c.countEmbeddedIn(ctx, page.Title, namespace)
+		if err != nil {
+			usage.Error = err.Error()
+		}
+		result.Templates = append(result.Templates, usage)
+	}
+	result.TemplatesScanned = len(result.Templates)
+
+	sort.SliceStable(result.Templates, func(i, j int) bool {
+		a, b := result.Templates[i], result.Templates[j]
+		if a.UsageCount != b.UsageCount {
+			return a.UsageCount > b.UsageCount
+		}
+		return a.Title < b.Title
+	})
+	return result, nil
+}
+
+// countEmbeddedIn counts the pages transcluding title, optionally limited to
+// one namespace. capped is true when counting stopped at
+// maxTemplateUsageCount.
+func (c *Client) countEmbeddedIn(ctx context.Context, title string, namespace *int) (count int, capped bool, err error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("list", "embeddedin")
+	params.Set("eititle", title)
+	params.Set("eilimit", "max")
+	if namespace != nil {
+		params.Set("einamespace", strconv.Itoa(*namespace))
+	}
+
+	for {
+		resp, err := c.apiRequest(ctx, params)
+		if err != nil {
+			return count, false, err
+		}
+		count += len(getSlice(getMap(resp["query"])["embeddedin"]))
+		if count >= maxTemplateUsageCount {
+			return maxTemplateUsageCount, true, nil
+		}
+		cont := getString(getMap(resp["continue"])["eicontinue"])
+		if cont == "" {
+			return count, false, nil
+		}
+		params.Set("eicontinue", cont)
+	}
+}
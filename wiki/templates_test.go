@@ -0,0 +1,266 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// expandTemplatesMockServer expands {{Foo}} to a fixed body and serves
+// pageContent for page queries.
+func expandTemplatesMockServer(t *testing.T, pageContent string, gotTitle *string) *httptest.Server {
+	t.Helper()
+	return mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "expandtemplates":
+			*gotTitle = r.FormValue("title")
+			expanded := strings.ReplaceAll(r.FormValue("text"), "{{Foo}}", "Foo template body")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"expandtemplates": map[string]interface{}{"wikitext": expanded},
+			})
+		case "query":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"5": map[string]interface{}{
+							"pageid": float64(5),
+							"title":  "Uses Foo",
+							"revisions": []interface{}{
+								map[string]interface{}{
+									"slots": map[string]interface{}{
+										"main": map[string]interface{}{"content": pageContent},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func TestExpandTemplates_Wikitext(t *testing.T) {
+	gotTitle := ""
+	server := expandTemplatesMockServer(t, "", &gotTitle)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.ExpandTemplates(context.Background(), ExpandTemplatesArgs{Wikitext: "Before {{Foo}} after"})
+	if err != nil {
+		t.Fatalf("ExpandTemplates failed: %v", err)
+	}
+	if result.Wikitext != "Before Foo template body after" {
+		t.Errorf("Wikitext = %q, want {{Foo}} expanded", result.Wikitext)
+	}
+	if gotTitle != "" {
+		t.Errorf("title param = %q, want none for raw wikitext", gotTitle)
+	}
+}
+
+func TestExpandTemplates_Title(t *testing.T) {
+	gotTitle := ""
+	server := expandTemplatesMockServer(t, "Intro\n{{Foo}}", &gotTitle)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.ExpandTemplates(context.Background(), ExpandTemplatesArgs{Title: "Uses Foo"})
+	if err != nil {
+		t.Fatalf("ExpandTemplates failed: %v", err)
+	}
+	if result.Wikitext != "Intro\nFoo template body" {
+		t.Errorf("Wikitext = %q, want page content with {{Foo}} expanded", result.Wikitext)
+	}
+	if gotTitle != "Uses Foo" || result.Title != "Uses Foo" {
+		t.Errorf("title = %q (param %q), want Uses Foo", result.Title, gotTitle)
+	}
+}
+
+func TestExpandTemplates_LargePageExpandedWhole(t *testing.T) {
+	content := strings.Repeat("x", CharacterLimit) + "\n{{Foo}}"
+	sent := ""
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") == "expandtemplates" {
+			sent = r.FormValue("text")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"expandtemplates": map[string]interface{}{"wikitext": "expanded"},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{"pages": map[string]interface{}{
+				"5": map[string]interface{}{"pageid": float64(5), "title": "Big Page", "revisions": []interface{}{
+					map[string]interface{}{"slots": map[string]interface{}{"main": map[string]interface{}{"content": content}}},
+				}},
+			}},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	if _, err := client.ExpandTemplates(context.Background(), ExpandTemplatesArgs{Title: "Big Page"}); err != nil {
+		t.Fatalf("ExpandTemplates failed: %v", err)
+	}
+	if sent != content {
+		t.Errorf("expanded %d bytes ending %q, want the whole %d-byte page", len(sent), sent[max(len(sent)-20, 0):], len(content))
+	}
+}
+
+func TestExpandTemplates_RequiresInput(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	if _, err := client.ExpandTemplates(context.Background(), ExpandTemplatesArgs{}); err == nil {
+		t.Error("expected error when neither title nor wikitext is given")
+	}
+}
+
+func TestTemplateTitle(t *testing.T) {
+	tests := map[string]string{
+		"Infobox":           "Template:Infobox",
+		"Template:Infobox":  "Template:Infobox",
+		"template: Infobox": "Template:Infobox",
+		" Infobox ":         "Template:Infobox",
+	}
+	for in, want := range tests {
+		if got := templateTitle(in); got != want {
+			t.Errorf("templateTitle(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestGetTemplateUsageStats(t *testing.T) {
+	// Template:Infobox is used on 3 pages across two embeddedin batches,
+	// Template:Stub on 1, Template:Old on none.
+	embeddedIn := map[string][][]string{
+		"Template:Infobox": {{"A", "B"}, {"C"}},
+		"Template:Stub":    {{"D"}},
+	}
+	var gotNamespace string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("list") {
+		case "allpages":
+			if r.FormValue("apnamespace") != "10" {
+				t.Errorf("apnamespace = %q, want 10", r.FormValue("apnamespace"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"allpages": []interface{}{
+						map[string]interface{}{"pageid": float64(1), "title": "Template:Old"},
+						map[string]interface{}{"pageid": float64(2), "title": "Template:Stub"},
+						map[string]interface{}{"pageid": float64(3), "title": "Template:Infobox"},
+					},
+				},
+			})
+		case "embeddedin":
+			gotNamespace = r.FormValue("einamespace")
+			batches := embeddedIn[r.FormValue("eititle")]
+			batch := 0
+			if r.FormValue("eicontinue") != "" {
+				batch = 1
+			}
+			pages := []interface{}{}
+			if batch < len(batches) {
+				for _, title := range batches[batch] {
+					pages = append(pages, map[string]interface{}{"title": title})
+				}
+			}
+			resp := map[string]interface{}{"query": map[string]interface{}{"embeddedin": pages}}
+			if batch+1 < len(batches) {
+				resp["continue"] = map[string]interface{}{"eicontinue": "10|next", "continue": "-||"}
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetTemplateUsageStats(context.Background(), TemplateUsageStatsArgs{Namespace: intPtr(0)})
+	if err != nil {
+		t.Fatalf("GetTemplateUsageStats: %v", err)
+	}
+	if gotNamespace != "0" {
+		t.Errorf("einamespace = %q, want 0", gotNamespace)
+	}
+	if result.TemplatesScanned != 3 {
+		t.Errorf("TemplatesScanned = %d, want 3", result.TemplatesScanned)
+	}
+	var got []string
+	for _, u := range result.Templates {
+		if u.Error != "" {
+			t.Errorf("%s: unexpected error %s", u.Title, u.Error)
+		}
+		got = append(got, fmt.Sprintf("%s=%d", u.Title, u.UsageCount))
+	}
+	want := "Template:Infobox=3,Template:Stub=1,Template:Old=0"
+	if strings.Join(got, ",") != want {
+		t.Errorf("usage = %s, want %s", strings.Join(got, ","), want)
+	}
+}
+
+func TestGetInfoboxTemplates(t *testing.T) {
+	uses := map[string]int{"Template:Infobox person": 2, "Template:Infobox company": 5}
+	var gotPrefix string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("list") {
+		case "allpages":
+			gotPrefix = r.FormValue("apprefix")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"allpages": []interface{}{
+						map[string]interface{}{"pageid": float64(1), "title": "Template:Infobox company"},
+						map[string]interface{}{"pageid": float64(2), "title": "Template:Infobox person"},
+					},
+				},
+			})
+		case "embeddedin":
+			pages := []interface{}{}
+			for i := 0; i < uses[r.FormValue("eititle")]; i++ {
+				pages = append(pages, map[string]interface{}{"title": fmt.Sprintf("Page %d", i)})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{"embeddedin": pages},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.GetInfoboxTemplates(context.Background(), InfoboxTemplatesArgs{})
+	if err != nil {
+		t.Fatalf("GetInfoboxTemplates: %v", err)
+	}
+	if gotPrefix != "Infobox" {
+		t.Errorf("apprefix = %q, want Infobox", gotPrefix)
+	}
+	want := []TemplateUsage{
+		{Title: "Template:Infobox company", UsageCount: 5},
+		{Title: "Template:Infobox person", UsageCount: 2},
+	}
+	if !reflect.DeepEqual(result.Templates, want) {
+		t.Errorf("Templates = %+v, want %+v", result.Templates, want)
+	}
+}
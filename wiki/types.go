@@ -48,9 +48,11 @@ func (b BaseWriteArgs) GetRationale() string {
 // SearchArgs contains parameters for full-text wiki search.
 type SearchArgs struct {
 	BaseArgs
-	Query  string `json:"query" jsonschema:"Search query text"`
-	Limit  int    `json:"limit,omitempty" jsonschema:"Maximum results to return (default 20, max 500)"`
-	Offset int    `json:"offset,omitempty" jsonschema:"Offset for pagination"`
+	Query           string `json:"query" jsonschema:"Search query text"`
+	Limit           int    `json:"limit,omitempty" jsonschema:"Maximum results to return (default 20, max 500)"`
+	Offset          int    `json:"offset,omitempty" jsonschema:"Offset for pagination"`
+	Namespace       *int   `json:"namespace,omitempty" jsonschema:"Only search this namespace (0 = main, 2 = User, 4 = Project, 10 = Template, 14 = Category). Default: the wiki's default search namespaces"`
+	ResolveSections bool   `json:"resolve_sections,omitempty" jsonschema:"Also find which section each hit's match is in, for Page#Section links. Costs extra requests, so only the first 10 hits are resolved"`
 }
 
 // SearchResult contains search results with pagination info.
@@ -64,10 +66,12 @@ type SearchResult struct {
 
 // SearchHit represents a single search result with snippet preview.
 type SearchHit struct {
-	PageID  int    `json:"page_id"`
-	Title   string `json:"title"`
-	Snippet string `json:"snippet"`
-	Size    int    `json:"size"`
+	PageID        int    `json:"page_id"`
+	Title         string `json:"title"`
+	Snippet       string `json:"snippet"`
+	Size          int    `json:"size"`
+	SectionTitle  string `json:"section_title,omitempty"`
+	SectionAnchor string `json:"section_anchor,omitempty"`
 }
 
 // ========== Page Content Types ==========
@@ -77,6 +81,9 @@ type GetPageArgs struct {
 	BaseArgs
 	Title  string `json:"title" jsonschema:"Page title to retrieve"`
 	Format string `json:"format,omitempty" jsonschema:"Output format: 'wikitext' (default) or 'html'"`
+	// Variant selects a LanguageConverter script variant (zh-hant, sr-el);
+	// it applies to HTML output only
+	Variant string `json:"variant,omitempty" jsonschema:"Language variant to convert the page to on multi-script wikis (e.g. 'zh-hant', 'sr-el'). Returns HTML; see mediawiki_get_wiki_info for available variants"`
 }
 
 // PageContent holds the content of a wiki page in wikitext or HTML format.
@@ -85,6 +92,7 @@ type PageContent struct {
 	PageID    int    `json:"page_id"`
 	Content   string `json:"content"`
 	Format    string `json:"format"`
+	Variant   string `json:"variant,omitempty"`
 	Revision  int    `json:"revision_id"`
 	Timestamp string `json:"timestamp"`
 	Truncated bool   `json:"truncated,omitempty"`
@@ -125,15 +133,16 @@ type PageContentResult struct {
 // GetPagesInfoBatchArgs contains parameters for retrieving metadata for multiple pages.
 type GetPagesInfoBatchArgs struct {
 	BaseArgs
-	Titles []string `json:"titles" jsonschema:"List of page titles to get info for (max 50)"`
+	Titles []string `json:"titles" jsonschema:"List of page titles to get info for (max 500, fetched 50 per request)"`
 }
 
 // GetPagesInfoBatchResult contains metadata for multiple pages.
 type GetPagesInfoBatchResult struct {
-	Pages        []PageInfo `json:"pages"`
-	TotalCount   int        `json:"total_count"`
-	ExistsCount  int        `json:"exists_count"`
-	MissingCount int        `json:"missing_count"`
+	Pages        []PageInfo        `json:"pages"`
+	TotalCount   int               `json:"total_count"`
+	ExistsCount  int               `json:"exists_count"`
+	MissingCount int               `json:"missing_count"`
+	Errors       map[string]string `json:"errors,omitempty"` // title -> reason, for missing pages and failed requests
 }
 
 // ========== List Pages Types ==========
@@ -234,17 +243,92 @@ type PageInfo struct {
 // ParseArgs contains parameters for parsing wikitext to HTML.
 type ParseArgs struct {
 	BaseArgs
-	Wikitext string `json:"wikitext" jsonschema:"Wikitext content to parse"`
-	Title    string `json:"title,omitempty" jsonschema:"Page title for context (affects template expansion)"`
+	Wikitext string   `json:"wikitext,omitempty" jsonschema:"Wikitext content to parse. If empty, the current revision of the page named by title is parsed"`
+	Title    string   `json:"title,omitempty" jsonschema:"Page title for context (affects template expansion), or the page to parse when wikitext is empty"`
+	Props    []string `json:"props,omitempty" jsonschema:"Parts to return: text, categories, links, sections, wikitext, headhtml. Default: text, categories, links"`
+	Section  *int     `json:"section,omitempty" jsonschema:"Parse only this section (0 = intro, 1+ = sections as numbered by get_sections)"`
 }
 
 // ParseResult contains HTML output and extracted metadata from parsed wikitext.
 type ParseResult struct {
-	HTML       string   `json:"html"`
-	Categories []string `json:"categories,omitempty"`
-	Links      []string `json:"links,omitempty"`
-	Truncated  bool     `json:"truncated,omitempty"`
-	Message    string   `json:"message,omitempty"`
+	HTML         string        `json:"html"`
+	DisplayTitle string        `json:"display_title,omitempty"`
+	Categories   []string      `json:"categories,omitempty"`
+	Links        []string      `json:"links,omitempty"`
+	Sections     []SectionInfo `json:"sections,omitempty"`
+	Wikitext     string        `json:"wikitext,omitempty"`
+	HeadHTML     string        `json:"head_html,omitempty"`
+	Truncated    bool          `json:"truncated,omitempty"`
+	Message      string        `json:"message,omitempty"`
+}
+
+// ========== Preview Edit Types ==========
+
+// PreviewEditArgs contains parameters for rendering an edit without saving it.
+type PreviewEditArgs struct {
+	BaseArgs
+	Title   string `json:"title" jsonschema:"Page the content would be saved to (sets the context for templates and magic words)"`
+	Content string `json:"content" jsonschema:"Full wikitext that would be saved"`
+}
+
+// PreviewEditResult contains the would-be rendering of an unsaved edit.
+type PreviewEditResult struct {
+	Title        string   `json:"title"`
+	DisplayTitle string   `json:"display_title,omitempty"`
+	HTML         string   `json:"html"`
+	Categories   []string `json:"categories,omitempty"`
+	Warnings     []string `json:"warnings,omitempty"`
+	Truncated    bool     `json:"truncated,omitempty"`
+	Message      string   `json:"message,omitempty"`
+}
+
+// ========== Expand Templates Types ==========
+
+// ExpandTemplatesArgs contains parameters for expanding templates in a page
+// or in raw wikitext.
+type ExpandTemplatesArgs struct {
+	BaseArgs
+	Title    string `json:"title,omitempty" jsonschema:"Page to expand. Without wikitext, the page's current wikitext is fetched and expanded; with wikitext, sets the page context for magic words like {{PAGENAME}}"`
+	Wikitext string `json:"wikitext,omitempty" jsonschema:"Raw wikitext to expand (alternative to fetching title)"`
+}
+
+// ExpandTemplatesResult contains fully template-expanded wikitext.
+type ExpandTemplatesResult struct {
+	Title     string `json:"title,omitempty"`
+	Wikitext  string `json:"wikitext"`
+	Truncated bool   `json:"truncated,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// ========== Template Usage Types ==========
+
+// TemplateUsageStatsArgs contains parameters for the template usage report.
+type TemplateUsageStatsArgs struct {
+	BaseArgs
+	Namespace *int `json:"namespace,omitempty" jsonschema:"Only count transclusions from pages in this namespace (e.g. 0 for articles). Omit to count all namespaces"`
+	Limit     int  `json:"limit,omitempty" jsonschema:"Max templates to scan (default 50, max 200)"`
+}
+
+// TemplateUsageStatsResult contains templates sorted by usage, most used first.
+type TemplateUsageStatsResult struct {
+	Templates        []TemplateUsage `json:"templates"`
+	TemplatesScanned int             `json:"templates_scanned"`
+	HasMore          bool            `json:"has_more"` // more templates exist beyond the scan limit
+}
+
+// InfoboxTemplatesArgs contains parameters for listing infobox templates.
+type InfoboxTemplatesArgs struct {
+	BaseArgs
+	Namespace *int `json:"namespace,omitempty" jsonschema:"Only count transclusions from pages in this namespace (e.g. 0 for articles). Omit to count all namespaces"`
+	Limit     int  `json:"limit,omitempty" jsonschema:"Max infobox templates to scan (default 50, max 200)"`
+}
+
+// TemplateUsage is the transclusion count for one template.
+type TemplateUsage struct {
+	Title      string `json:"title"`
+	UsageCount int    `json:"usage_count"`
+	Capped     bool   `json:"capped,omitempty"` // counting stopped at the per-template cap
+	Error      string `json:"error,omitempty"`
 }
 
 // ========== Wiki Info Types ==========
@@ -268,6 +352,19 @@ type WikiInfo struct {
 	Timezone    string     `json:"timezone"`
 	WriteAPI    bool       `json:"write_api_enabled"`
 	Statistics  *WikiStats `json:"statistics,omitempty"`
+
+	// Variants lists the script variants of the content language on
+	// LanguageConverter wikis (zh, sr, ...); empty elsewhere
+	Variants []LanguageVariant `json:"variants,omitempty"`
+	// LanguageFallbacks are the codes MediaWiki falls back to, in order,
+	// for messages missing in the content language
+	LanguageFallbacks []string `json:"language_fallbacks,omitempty"`
+}
+
+// LanguageVariant is one script variant a page can be converted to.
+type LanguageVariant struct {
+	Code string `json:"code"`
+	Name string `json:"name,omitempty"`
 }
 
 // WikiStats contains numerical statistics about the wiki.
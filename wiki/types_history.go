@@ -24,6 +24,23 @@ type RecentChangesResult struct {
 	Aggregated   *AggregatedChanges `json:"aggregated,omitempty"`
 }
 
+// RecentChangesFeedArgs contains parameters for exporting recent changes as
+// a syndication feed.
+type RecentChangesFeedArgs struct {
+	BaseArgs
+	Limit     int    `json:"limit,omitempty" jsonschema:"Maximum changes to include (default 50, max 500)"`
+	Namespace int    `json:"namespace,omitempty" jsonschema:"Filter by namespace (-1 for all)"`
+	Format    string `json:"format,omitempty" jsonschema:"Feed format: 'atom' (default) or 'rss'"`
+}
+
+// RecentChangesFeedResult contains a rendered Atom or RSS document.
+type RecentChangesFeedResult struct {
+	Format      string `json:"format"`
+	ContentType string `json:"content_type"`
+	Entries     int    `json:"entries"`
+	XML         string `json:"xml"`
+}
+
 // AggregatedChanges groups changes by user, page, or type for summaries.
 type AggregatedChanges struct {
 	By           string           `json:"by"`
@@ -94,6 +111,7 @@ type CompareRevisionsArgs struct {
 	ToRev     int    `json:"to_rev,omitempty" jsonschema:"Target revision ID"`
 	FromTitle string `json:"from_title,omitempty" jsonschema:"Source page title (uses latest revision)"`
 	ToTitle   string `json:"to_title,omitempty" jsonschema:"Target page title (uses latest revision)"`
+	Format    string `json:"format,omitempty" jsonschema:"Diff format: 'html' (MediaWiki diff table) or 'markdown' (fenced diff block with +/- lines and a change summary). When omitted, JSON pages get a key-level JSON diff and other pages the HTML diff"`
 }
 
 // CompareRevisionsResult contains the diff between two revisions.
@@ -103,10 +121,55 @@ type CompareRevisionsResult struct {
 	ToTitle       string `json:"to_title"`
 	ToRevID       int    `json:"to_revid"`
 	Diff          string `json:"diff"`
+	Format        string `json:"format"`
+	LinesAdded    int    `json:"lines_added,omitempty"`
+	LinesRemoved  int    `json:"lines_removed,omitempty"`
 	FromUser      string `json:"from_user,omitempty"`
 	ToUser        string `json:"to_user,omitempty"`
 	FromTimestamp string `json:"from_timestamp,omitempty"`
 	ToTimestamp   string `json:"to_timestamp,omitempty"`
+	// JSONChanges lists the key-level changes when Format is "json"
+	JSONChanges []JSONChange `json:"json_changes,omitempty"`
+}
+
+// JSONChange is one difference between two JSON documents. Path addresses
+// the value with dotted keys and [n] array indexes ("settings.colors[2]").
+type JSONChange struct {
+	Path   string      `json:"path"`
+	Change string      `json:"change"` // "added", "removed" or "changed"
+	Old    interface{} `json:"old,omitempty"`
+	New    interface{} `json:"new,omitempty"`
+}
+
+// ========== Edit War Types ==========
+
+// DetectEditWarsArgs contains parameters for detecting edit wars.
+type DetectEditWarsArgs struct {
+	BaseArgs
+	Title      string `json:"title,omitempty" jsonschema:"Check only this page (default: the busiest pages in recent changes)"`
+	Since      string `json:"since,omitempty" jsonschema:"Start of the window (ISO 8601, default 24 hours ago)"`
+	MinReverts int    `json:"min_reverts,omitempty" jsonschema:"Reverts needed to flag a page (default 3)"`
+	Namespace  int    `json:"namespace,omitempty" jsonschema:"Namespace to scan recent changes in (default 0 = main, -1 for all). Ignored with title"`
+}
+
+// DetectEditWarsResult lists the pages with suspected edit wars.
+type DetectEditWarsResult struct {
+	Since        string    `json:"since"`
+	MinReverts   int       `json:"min_reverts"`
+	PagesScanned int       `json:"pages_scanned"`
+	EditWars     []EditWar `json:"edit_wars"`
+	Count        int       `json:"count"`
+}
+
+// EditWar describes the reverts on one page within the window.
+type EditWar struct {
+	Title           string   `json:"title"`
+	Edits           int      `json:"edits"`
+	Reverts         int      `json:"reverts"`
+	Participants    []string `json:"participants"`
+	FirstRevert     string   `json:"first_revert"`
+	LastRevert      string   `json:"last_revert"`
+	RevertRevisions []int    `json:"revert_revisions"`
 }
 
 // ========== User Contributions Types ==========
@@ -143,3 +206,31 @@ type UserContribution struct {
 	Minor     bool   `json:"minor,omitempty"`
 	New       bool   `json:"new,omitempty"`
 }
+
+// ========== Deleted Revisions Types ==========
+
+// GetDeletedRevisionsArgs contains parameters for listing a page's deleted revisions.
+type GetDeletedRevisionsArgs struct {
+	BaseArgs
+	Title string `json:"title" jsonschema:"Title of the deleted (or partially deleted) page"`
+	Limit int    `json:"limit,omitempty" jsonschema:"Max deleted revisions to return (default 20, max 100)"`
+}
+
+// GetDeletedRevisionsResult contains the deleted revisions of a page.
+type GetDeletedRevisionsResult struct {
+	Title     string            `json:"title"`
+	Revisions []DeletedRevision `json:"revisions"`
+	Count     int               `json:"count"`
+	HasMore   bool              `json:"has_more"`
+}
+
+// DeletedRevision describes a single revision held in the deletion archive.
+type DeletedRevision struct {
+	RevID     int    `json:"revid"`
+	ParentID  int    `json:"parentid,omitempty"`
+	User      string `json:"user"`
+	Timestamp string `json:"timestamp"`
+	Size      int    `json:"size"`
+	Comment   string `json:"comment"`
+	Minor     bool   `json:"minor,omitempty"`
+}
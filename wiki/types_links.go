@@ -77,6 +77,8 @@ type FindBrokenInternalLinksArgs struct {
 	Pages    []string `json:"pages,omitempty" jsonschema:"Page titles to check for broken internal links"`
 	Category string   `json:"category,omitempty" jsonschema:"Category to get pages from (alternative to pages)"`
 	Limit    int      `json:"limit,omitempty" jsonschema:"Max pages to check (default 20, max 100)"`
+
+	MaxIssuesPerPage int `json:"max_issues_per_page,omitempty" jsonschema:"Max broken links listed per page (default 100, max 1000). Pages with more are marked truncated; broken_count still counts them all"`
 }
 
 // FindBrokenInternalLinksResult contains broken wiki links found across pages.
@@ -87,10 +89,13 @@ type FindBrokenInternalLinksResult struct {
 }
 
 // PageBrokenLinksResult contains broken links for a single page.
+// BrokenCount counts every broken link; when it exceeds the per-page cap
+// only the first are listed and Truncated is set.
 type PageBrokenLinksResult struct {
 	Title       string       `json:"title"`
 	BrokenLinks []BrokenLink `json:"broken_links"`
 	BrokenCount int          `json:"broken_count"`
+	Truncated   bool         `json:"truncated,omitempty"`
 	Error       string       `json:"error,omitempty"`
 }
 
@@ -101,6 +106,25 @@ type BrokenLink struct {
 	Line    int    `json:"line,omitempty"`
 }
 
+// FindMissingModulesArgs contains parameters for finding {{#invoke}} calls
+// to non-existent Lua modules.
+type FindMissingModulesArgs struct {
+	BaseArgs
+	Pages    []string `json:"pages,omitempty" jsonschema:"Page titles to scan for #invoke calls"`
+	Category string   `json:"category,omitempty" jsonschema:"Category to get pages from (alternative to pages)"`
+	Limit    int      `json:"limit,omitempty" jsonschema:"Max pages to check (default 20, max 100)"`
+
+	MaxIssuesPerPage int `json:"max_issues_per_page,omitempty" jsonschema:"Max missing modules listed per page (default 100, max 1000)"`
+}
+
+// FindMissingModulesResult lists, per page, the invoked Module: pages that
+// do not exist. Each entry's target is the full module title.
+type FindMissingModulesResult struct {
+	PagesChecked int                     `json:"pages_checked"`
+	MissingCount int                     `json:"missing_count"`
+	Pages        []PageBrokenLinksResult `json:"pages"`
+}
+
 // ========== Orphaned Pages Types ==========
 
 // FindOrphanedPagesArgs contains parameters for finding pages with no incoming links.
@@ -126,6 +150,60 @@ type OrphanedPage struct {
 	LastEdited string `json:"last_edited,omitempty"`
 }
 
+// ========== Dead-End Pages Types ==========
+
+// FindDeadEndPagesArgs contains parameters for finding pages with no outgoing links.
+type FindDeadEndPagesArgs struct {
+	BaseArgs
+	Namespace int    `json:"namespace,omitempty" jsonschema:"Namespace to check (0=main, default). Use -1 for all namespaces."`
+	Limit     int    `json:"limit,omitempty" jsonschema:"Max pages to return (default 50, max 200)"`
+	Prefix    string `json:"prefix,omitempty" jsonschema:"Only check pages starting with this prefix"`
+}
+
+// FindDeadEndPagesResult contains pages that link to no other wiki page.
+type FindDeadEndPagesResult struct {
+	DeadEndPages []DeadEndPage `json:"dead_end_pages"`
+	TotalChecked int           `json:"total_checked"`
+	DeadEndCount int           `json:"dead_end_count"`
+}
+
+// DeadEndPage represents a page with no outgoing links.
+type DeadEndPage struct {
+	Title      string `json:"title"`
+	PageID     int    `json:"page_id"`
+	Length     int    `json:"length"`
+	LastEdited string `json:"last_edited,omitempty"`
+}
+
+// ========== Link Count Report Types ==========
+
+// GetMostLinkedPagesArgs contains parameters for listing the most linked-to pages.
+type GetMostLinkedPagesArgs struct {
+	BaseArgs
+	Namespace int `json:"namespace,omitempty" jsonschema:"Namespace to report (0=main, default). Use -1 for all namespaces."`
+	Limit     int `json:"limit,omitempty" jsonschema:"Max pages to return (default 50, max 200)"`
+}
+
+// GetWantedPagesArgs contains parameters for listing missing pages that are linked to.
+type GetWantedPagesArgs struct {
+	BaseArgs
+	Namespace int `json:"namespace,omitempty" jsonschema:"Namespace to report (0=main, default). Use -1 for all namespaces."`
+	Limit     int `json:"limit,omitempty" jsonschema:"Max pages to return (default 50, max 200)"`
+}
+
+// PageLinkCountsResult contains pages ranked by incoming-link count.
+type PageLinkCountsResult struct {
+	Pages []PageLinkCount `json:"pages"`
+	Count int             `json:"count"`
+}
+
+// PageLinkCount is a page with its number of incoming links.
+type PageLinkCount struct {
+	Title     string `json:"title"`
+	Namespace int    `json:"namespace"`
+	Links     int    `json:"links"`
+}
+
 // ========== Backlinks Types ==========
 
 // GetBacklinksArgs contains parameters for finding pages that link to a target.
@@ -1,5 +1,7 @@
 package wiki
 
+import "regexp"
+
 // ========== Terminology Check Types ==========
 
 // CheckTerminologyArgs contains parameters for checking terminology consistency.
@@ -10,6 +12,7 @@ type CheckTerminologyArgs struct {
 	GlossaryPage      string   `json:"glossary_page,omitempty" jsonschema:"Wiki page containing the glossary table (default: 'Brand Terminology Glossary')"`
 	Limit             int      `json:"limit,omitempty" jsonschema:"Max pages to check (default 10, max 50)"`
 	ExcludeCodeBlocks *bool    `json:"exclude_code_blocks,omitempty" jsonschema:"Skip code blocks (syntaxhighlight, source, pre, code tags) to avoid false positives on code paths. Default: true"`
+	MaxIssuesPerPage  int      `json:"max_issues_per_page,omitempty" jsonschema:"Max issues listed per page (default 100, max 1000). Pages with more are marked truncated; issue_count still counts them all"`
 }
 
 // CheckTerminologyResult contains terminology violations found across pages.
@@ -22,10 +25,13 @@ type CheckTerminologyResult struct {
 }
 
 // PageTerminologyResult contains terminology issues for a single page.
+// IssueCount counts every issue found; when it exceeds the per-page cap only
+// the first issues are listed and Truncated is set.
 type PageTerminologyResult struct {
 	Title      string             `json:"title"`
 	IssueCount int                `json:"issue_count"`
 	Issues     []TerminologyIssue `json:"issues"`
+	Truncated  bool               `json:"truncated,omitempty"`
 	Error      string             `json:"error,omitempty"`
 }
 
@@ -44,6 +50,182 @@ type GlossaryTerm struct {
 	Correct   string `json:"correct"`
 	Pattern   string `json:"pattern,omitempty"`
 	Notes     string `json:"notes,omitempty"`
+
+	// matcher is the compiled Pattern (or quoted Incorrect), set when the
+	// glossary is parsed; nil if the pattern does not compile.
+	matcher *regexp.Regexp
+}
+
+// ========== Spelling Check Types ==========
+
+// CheckSpellingArgs contains parameters for checking pages against a
+// spelling dictionary page.
+type CheckSpellingArgs struct {
+	BaseArgs
+	Pages            []string `json:"pages,omitempty" jsonschema:"Page titles to check. If empty, uses pages from category."`
+	Category         string   `json:"category,omitempty" jsonschema:"Category to get pages from (alternative to pages list)"`
+	DictionaryPage   string   `json:"dictionary_page,omitempty" jsonschema:"Wiki page containing the allow/deny word table (default: 'Spelling Dictionary')"`
+	Limit            int      `json:"limit,omitempty" jsonschema:"Max pages to check (default 10, max 50)"`
+	MaxIssuesPerPage int      `json:"max_issues_per_page,omitempty" jsonschema:"Max issues listed per page (default 100, max 1000). Pages with more are marked truncated; issue_count still counts them all"`
+}
+
+// CheckSpellingResult contains spelling issues found across pages.
+type CheckSpellingResult struct {
+	PagesChecked   int                  `json:"pages_checked"`
+	IssuesFound    int                  `json:"issues_found"`
+	DictionaryPage string               `json:"dictionary_page"`
+	AllowedWords   int                  `json:"allowed_words"`
+	DeniedWords    int                  `json:"denied_words"`
+	Pages          []PageSpellingResult `json:"pages"`
+}
+
+// PageSpellingResult contains spelling issues for a single page.
+// IssueCount counts every issue found; when it exceeds the per-page cap only
+// the first issues are listed and Truncated is set.
+type PageSpellingResult struct {
+	Title      string          `json:"title"`
+	IssueCount int             `json:"issue_count"`
+	Issues     []SpellingIssue `json:"issues"`
+	Truncated  bool            `json:"truncated,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// SpellingIssue describes a single spelling violation. Type is "denied" for
+// denylisted words, "unknown" for words missing from the allowlist, and
+// "variant" for a non-approved form of an allowlisted word.
+type SpellingIssue struct {
+	Word       string `json:"word"`
+	Type       string `json:"type"`
+	Suggestion string `json:"suggestion,omitempty"`
+	Line       int    `json:"line"`
+	Context    string `json:"context"`
+}
+
+// ========== Wikitext Balance Types ==========
+
+// BalanceIssue describes an unmatched wikitext delimiter found by
+// CheckWikitextBalance.
+type BalanceIssue struct {
+	Line    int    `json:"line"`
+	Token   string `json:"token"`
+	Message string `json:"message"`
+}
+
+// ========== Inlined Template Content Types ==========
+
+// FindInlinedTemplateContentArgs contains parameters for finding pages that
+// paste a template's content instead of transcluding it.
+type FindInlinedTemplateContentArgs struct {
+	BaseArgs
+	TemplateName string   `json:"template_name" jsonschema:"Template name (with or without 'Template:' prefix)"`
+	Pages        []string `json:"pages,omitempty" jsonschema:"Page titles to check. If empty, uses pages from category."`
+	Category     string   `json:"category,omitempty" jsonschema:"Category to get pages from (alternative to pages list)"`
+	Limit        int      `json:"limit,omitempty" jsonschema:"Max pages to check (default 10, max 50)"`
+}
+
+// FindInlinedTemplateContentResult lists pages containing a template's
+// expanded text inline.
+type FindInlinedTemplateContentResult struct {
+	Template       string                 `json:"template"`
+	TemplateLength int                    `json:"template_length"`
+	PagesChecked   int                    `json:"pages_checked"`
+	MatchesFound   int                    `json:"matches_found"`
+	Matches        []InlinedTemplateMatch `json:"matches"`
+	Errors         []string               `json:"errors,omitempty"`
+	Message        string                 `json:"message"`
+}
+
+// InlinedTemplateMatch is a page that contains a template's content inline.
+type InlinedTemplateMatch struct {
+	Title      string `json:"title"`
+	Suggestion string `json:"suggestion"`
+}
+
+// ========== Stale References Types ==========
+
+// FindStaleReferencesArgs contains parameters for finding hardcoded dates
+// and versions.
+type FindStaleReferencesArgs struct {
+	BaseArgs
+	Pages    []string `json:"pages,omitempty" jsonschema:"Page titles to check. If empty, uses pages from category."`
+	Category string   `json:"category,omitempty" jsonschema:"Category to get pages from (alternative to pages list)"`
+	Patterns []string `json:"patterns,omitempty" jsonschema:"Regex patterns to look for, replacing the defaults (past 'as of <year>' phrases, copyright years, version numbers). A named group 'year' limits matches to years before the current one."`
+	Limit    int      `json:"limit,omitempty" jsonschema:"Max pages to check (default 10, max 50)"`
+}
+
+// FindStaleReferencesResult contains hardcoded date and version matches.
+type FindStaleReferencesResult struct {
+	PagesChecked int                   `json:"pages_checked"`
+	MatchesFound int                   `json:"matches_found"`
+	Patterns     []string              `json:"patterns"`
+	Pages        []PageStaleReferences `json:"pages"`
+}
+
+// PageStaleReferences contains stale reference matches for a single page.
+type PageStaleReferences struct {
+	Title   string           `json:"title"`
+	Matches []StaleReference `json:"matches,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+// StaleReference is one hardcoded date or version found in a page.
+type StaleReference struct {
+	Pattern string `json:"pattern"`
+	Match   string `json:"match"`
+	Line    int    `json:"line"`
+	Context string `json:"context"`
+}
+
+// ========== Citation Types ==========
+
+// GetCitationsArgs contains parameters for listing a page's <ref> citations.
+type GetCitationsArgs struct {
+	BaseArgs
+	Title string `json:"title" jsonschema:"Page title to list citations for"`
+}
+
+// GetCitationsResult contains the citations found on a page.
+type GetCitationsResult struct {
+	Title     string     `json:"title"`
+	RefCount  int        `json:"ref_count"` // <ref> tags, including reuses
+	Citations []Citation `json:"citations"`
+}
+
+// Citation is one distinct reference on a page. Named references are keyed
+// by name; unnamed inline references with identical bodies are grouped.
+type Citation struct {
+	Name    string `json:"name,omitempty"`
+	Group   string `json:"group,omitempty"`
+	Content string `json:"content,omitempty"`
+	Uses    int    `json:"uses"`
+	// Undefined is set for a named reference that is only ever reused
+	// (<ref name="x"/>) and never given a body, which renders as a
+	// cite error on the page.
+	Undefined bool `json:"undefined,omitempty"`
+}
+
+// FindDuplicateCitationsArgs contains parameters for finding citations
+// with identical bodies.
+type FindDuplicateCitationsArgs struct {
+	BaseArgs
+	Title string `json:"title" jsonschema:"Page title to check for duplicate citations"`
+}
+
+// FindDuplicateCitationsResult contains citations that share a body.
+type FindDuplicateCitationsResult struct {
+	Title      string              `json:"title"`
+	Duplicates []DuplicateCitation `json:"duplicates"`
+	Message    string              `json:"message"`
+}
+
+// DuplicateCitation is a citation body defined more than once, under
+// different names or inline without a name.
+type DuplicateCitation struct {
+	Content     string   `json:"content"`
+	Names       []string `json:"names,omitempty"`
+	InlineCount int      `json:"inline_count,omitempty"` // unnamed definitions
+	Uses        int      `json:"uses"`
+	Suggestion  string   `json:"suggestion"`
 }
 
 // ========== Translation Check Types ==========
@@ -158,6 +340,8 @@ type WikiHealthAuditArgs struct {
 	Category string   `json:"category,omitempty" jsonschema:"Category to audit (alternative to pages)"`
 	Limit    int      `json:"limit,omitempty" jsonschema:"Max pages to audit (default 20, max 50)"`
 	Checks   []string `json:"checks,omitempty" jsonschema:"Which checks to run: 'links', 'terminology', 'orphans', 'external', 'activity'. Default: all except 'external'"`
+
+	ExplainOnly bool `json:"explain_only,omitempty" jsonschema:"Return the audit plan (checks, resolved pages, estimated API calls) without running any check"`
 }
 
 // WikiHealthAuditResult contains the aggregated results of a wiki health audit.
@@ -172,9 +356,30 @@ type WikiHealthAuditResult struct {
 	OrphanedPages  *FindOrphanedPagesResult       `json:"orphaned_pages,omitempty"`
 	ExternalLinks  *CheckLinksResult              `json:"external_links,omitempty"`
 	RecentActivity *AggregatedChanges             `json:"recent_activity,omitempty"`
+	Plan           *HealthAuditPlan               `json:"plan,omitempty"`
 	Errors         []string                       `json:"errors,omitempty"`
 }
 
+// HealthAuditPlan describes what a health audit would do, returned instead
+// of check results when ExplainOnly is set.
+type HealthAuditPlan struct {
+	Checks            []PlannedHealthCheck `json:"checks"`
+	UnknownChecks     []string             `json:"unknown_checks,omitempty"`
+	Pages             []string             `json:"pages"`
+	PageCount         int                  `json:"page_count"`
+	ExternalSample    []string             `json:"external_sample,omitempty"`
+	EstimatedAPICalls int                  `json:"estimated_api_calls"`
+}
+
+// PlannedHealthCheck is one check in a HealthAuditPlan. Scope is "pages"
+// for checks over the resolved pages and "wiki" for wiki-wide ones.
+type PlannedHealthCheck struct {
+	Name              string `json:"name"`
+	Scope             string `json:"scope"`
+	EstimatedAPICalls int    `json:"estimated_api_calls"`
+	Note              string `json:"note,omitempty"`
+}
+
 // WikiHealthAuditSummary provides a quick overview of audit findings.
 type WikiHealthAuditSummary struct {
 	BrokenLinksCount    int `json:"broken_links_count"`
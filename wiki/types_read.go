@@ -46,6 +46,7 @@ type GetSectionsResult struct {
 	Sections       []SectionInfo `json:"sections,omitempty"`
 	SectionContent string        `json:"section_content,omitempty"`
 	SectionTitle   string        `json:"section_title,omitempty"`
+	Revision       int           `json:"revision,omitempty"` // revision the section content was read from
 	Format         string        `json:"format,omitempty"`
 	Message        string        `json:"message,omitempty"`
 }
@@ -57,6 +58,10 @@ type SectionInfo struct {
 	Title   string `json:"title"`
 	Anchor  string `json:"anchor"`
 	LineNum int    `json:"line_number,omitempty"`
+	// ByteOffset is the heading's position in the page wikitext, or -1 for
+	// sections that come from a transcluded template. It is used to map a
+	// text position to its section and is not part of the tool output.
+	ByteOffset int `json:"-"`
 }
 
 // ========== Related Pages Types ==========
@@ -122,8 +127,12 @@ type UploadFileResult struct {
 	PageID   int      `json:"page_id,omitempty"`
 	URL      string   `json:"url,omitempty"`
 	Size     int      `json:"size,omitempty"`
+	DryRun   bool     `json:"dry_run,omitempty"`
 	Message  string   `json:"message"`
 	Warnings []string `json:"warnings,omitempty"`
+	// AlreadyPresent is set when the target file already had identical
+	// content and the upload was skipped.
+	AlreadyPresent bool `json:"already_present,omitempty"`
 }
 
 // ========== Get Images Types ==========
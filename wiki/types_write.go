@@ -6,7 +6,7 @@ package wiki
 type EditPageArgs struct {
 	BaseWriteArgs
 	Title       string `json:"title" jsonschema:"Page title to edit or create"`
-	Content     string `json:"content" jsonschema:"New page content in wikitext format"`
+	Content     string `json:"content,omitempty" jsonschema:"New page content in wikitext format (required unless append_text or prepend_text is set)"`
 	Summary     string `json:"summary,omitempty" jsonschema:"Edit summary explaining the change"`
 	Minor       bool   `json:"minor,omitempty" jsonschema:"Mark as minor edit"`
 	Bot         bool   `json:"bot,omitempty" jsonschema:"Mark as bot edit (requires bot flag)"`
@@ -19,6 +19,54 @@ type EditPageArgs struct {
 	// rejects the edit with an 'editconflict' error if the page changed
 	// after that revision, instead of silently overwriting the newer edit.
 	BaseTimestamp string `json:"base_timestamp,omitempty" jsonschema:"Timestamp of the revision this edit is based on (from get_page). When set, the wiki rejects the edit with an editconflict error if someone else edited the page in the meantime, instead of silently overwriting their change"`
+
+	// ValidateFirst parse-checks the content with ValidateWikitext before
+	// saving and refuses the edit if any issues are found.
+	ValidateFirst bool `json:"validate_first,omitempty" jsonschema:"Parse-check the wikitext before saving and refuse the edit if broken templates, tables, or parser errors are found"`
+
+	// AllowBlanking disables the blanking guard, which otherwise refuses
+	// whole-page edits that empty the page or remove most of its content.
+	AllowBlanking bool `json:"allow_blanking,omitempty" jsonschema:"Allow an edit that empties the page or removes most of its content (by default, shrinking a page by more than 90% is refused as a likely mistake)"`
+
+	// CreateOnly makes MediaWiki reject the edit with an 'articleexists'
+	// error if the page already exists.
+	CreateOnly bool `json:"create_only,omitempty" jsonschema:"Only create the page; fail with an articleexists error if it already exists"`
+
+	// AppendText and PrependText add text to the end or start of the page
+	// (or of Section) instead of replacing it. MediaWiki merges them into
+	// the current revision server-side, so concurrent edits are not lost.
+	// They cannot be combined with Content.
+	AppendText  string `json:"append_text,omitempty" jsonschema:"Wikitext to add to the end of the page (or section) instead of replacing it; use instead of content"`
+	PrependText string `json:"prepend_text,omitempty" jsonschema:"Wikitext to add to the start of the page (or section) instead of replacing it; use instead of content"`
+}
+
+// addsText reports whether the edit appends or prepends rather than
+// replacing the page content.
+func (a EditPageArgs) addsText() bool { return a.AppendText != "" || a.PrependText != "" }
+
+// submittedText returns the wikitext the edit sends: the added text for
+// append and prepend edits, the full content otherwise. It is what gets
+// validated and hashed in the audit log.
+func (a EditPageArgs) submittedText() string {
+	if a.addsText() {
+		return a.PrependText + a.AppendText
+	}
+	return a.Content
+}
+
+// EditSectionArgs contains parameters for replacing a single section of a page.
+type EditSectionArgs struct {
+	BaseWriteArgs
+	Title   string `json:"title" jsonschema:"Page title containing the section"`
+	Section *int   `json:"section" jsonschema:"Section index from get_sections (0 = intro before the first heading, 1+ = sections). Required"`
+	Content string `json:"content" jsonschema:"Replacement wikitext for the section, including its heading line"`
+	Summary string `json:"summary,omitempty" jsonschema:"Edit summary explaining the change"`
+	Minor   bool   `json:"minor,omitempty" jsonschema:"Mark as minor edit"`
+
+	// BaseRevID is the revision the section edit is based on (PageInfo or
+	// GetRevisions). When set, MediaWiki rejects the edit with 'editconflict'
+	// if a later revision touched the same section.
+	BaseRevID int `json:"base_revid,omitempty" jsonschema:"Revision ID the edit is based on. When set, the wiki rejects the edit with an editconflict error if the section changed since that revision"`
 }
 
 // EditResult contains the result of a page edit operation.
@@ -33,6 +81,14 @@ type EditResult struct {
 	CaptchaType     string `json:"captcha_type,omitempty"`
 	CaptchaID       string `json:"captcha_id,omitempty"`
 	CaptchaQuestion string `json:"captcha_question,omitempty"`
+	DryRun          bool   `json:"dry_run,omitempty"`
+	Diff            string `json:"diff,omitempty"` // Markdown diff of a dry-run whole-page edit
+}
+
+// WikitextIssue describes a problem found while parse-checking wikitext.
+type WikitextIssue struct {
+	Type    string `json:"type"` // "parser_warning", "parser_error", or "unbalanced_markup"
+	Message string `json:"message"`
 }
 
 // EditRevisionInfo contains revision tracking info for edit operations
@@ -185,8 +241,151 @@ type MovePageResult struct {
 	To          string `json:"to"`
 	Reason      string `json:"reason,omitempty"`
 	RedirectURL string `json:"redirect_url,omitempty"`
-	TalkMoved   bool   `json:"talk_moved,omitempty"`
-	Message     string `json:"message"`
+	// RedirectCreated reports whether MediaWiki left a redirect at From
+	RedirectCreated bool     `json:"redirect_created"`
+	TalkMoved       bool     `json:"talk_moved,omitempty"`
+	Warnings        []string `json:"warnings,omitempty"`
+	DryRun          bool     `json:"dry_run,omitempty"`
+	Message         string   `json:"message"`
+}
+
+// ========== Delete Page Types ==========
+
+// DeletePageArgs contains parameters for deleting a wiki page.
+type DeletePageArgs struct {
+	BaseWriteArgs
+	Title  string `json:"title" jsonschema:"Title of the page to delete"`
+	Reason string `json:"reason,omitempty" jsonschema:"Reason for the deletion, shown in the deletion log"`
+}
+
+// DeletePageResult contains the result of a page deletion.
+type DeletePageResult struct {
+	Success bool   `json:"success"`
+	Title   string `json:"title"`
+	Reason  string `json:"reason,omitempty"`
+	LogID   int    `json:"log_id,omitempty"`
+	// RevisionsDeleted counts the page's revisions at deletion time. When
+	// RevisionsCapped is set counting stopped early and the page had more.
+	RevisionsDeleted int    `json:"revisions_deleted"`
+	RevisionsCapped  bool   `json:"revisions_capped,omitempty"`
+	DryRun           bool   `json:"dry_run,omitempty"`
+	Message          string `json:"message"`
+}
+
+// UndeletePageArgs contains parameters for restoring a deleted page.
+type UndeletePageArgs struct {
+	BaseWriteArgs
+	Title      string   `json:"title" jsonschema:"Title of the deleted page to restore"`
+	Reason     string   `json:"reason,omitempty" jsonschema:"Reason for the restore, shown in the deletion log"`
+	Timestamps []string `json:"timestamps,omitempty" jsonschema:"Timestamps of the deleted revisions to restore (from mediawiki_get_deleted_revisions). Omit to restore all."`
+}
+
+// UndeletePageResult contains the result of restoring a deleted page.
+type UndeletePageResult struct {
+	Success           bool   `json:"success"`
+	Title             string `json:"title"`
+	Reason            string `json:"reason,omitempty"`
+	RevisionsRestored int    `json:"revisions_restored"`
+	FilesRestored     int    `json:"files_restored,omitempty"`
+	DryRun            bool   `json:"dry_run,omitempty"`
+	Message           string `json:"message"`
+}
+
+// ========== Protect Page Types ==========
+
+// ProtectPageArgs contains parameters for changing a page's protection.
+type ProtectPageArgs struct {
+	BaseWriteArgs
+	Title       string            `json:"title" jsonschema:"Title of the page to protect"`
+	Protections map[string]string `json:"protections" jsonschema:"Protection level per action, e.g. {\"edit\": \"sysop\", \"move\": \"autoconfirmed\"}. Use \"all\" to remove protection for an action."`
+	Expiry      string            `json:"expiry,omitempty" jsonschema:"When the protection expires, as a timestamp or relative time like '1 week' (default infinite)"`
+	Reason      string            `json:"reason,omitempty" jsonschema:"Reason for the change, shown in the protection log"`
+	Cascade     bool              `json:"cascade,omitempty" jsonschema:"Also protect pages transcluded on this page (edit protection at sysop level only)"`
+}
+
+// ProtectPageResult contains the result of a protection change. Before and
+// After use the same "action: level" entries as PageInfo.Protection.
+type ProtectPageResult struct {
+	Success bool                `json:"success"`
+	Title   string              `json:"title"`
+	Reason  string              `json:"reason,omitempty"`
+	Before  []string            `json:"before"`
+	After   []string            `json:"after"`
+	Applied []AppliedProtection `json:"applied,omitempty"`
+	Cascade bool                `json:"cascade,omitempty"`
+	DryRun  bool                `json:"dry_run,omitempty"`
+	Message string              `json:"message"`
+}
+
+// AppliedProtection is one protection set by a ProtectPage call.
+type AppliedProtection struct {
+	Action string `json:"action"`
+	Level  string `json:"level"`
+	Expiry string `json:"expiry"`
+}
+
+// ========== Null Edit Types ==========
+
+// NullEditPagesArgs contains parameters for null-editing a list of pages.
+type NullEditPagesArgs struct {
+	BaseWriteArgs
+	Pages []string `json:"pages" jsonschema:"Page titles to re-save unchanged so their links and categories are re-parsed (max 200)"`
+}
+
+// NullEditPagesResult summarizes a bulk null edit.
+type NullEditPagesResult struct {
+	PagesProcessed int              `json:"pages_processed"`
+	SuccessCount   int              `json:"success_count"`
+	FailureCount   int              `json:"failure_count"`
+	DryRun         bool             `json:"dry_run,omitempty"`
+	Results        []NullEditResult `json:"results"`
+	Message        string           `json:"message"`
+}
+
+// NullEditResult reports the null edit of a single page.
+type NullEditResult struct {
+	Title   string `json:"title"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ========== Publish Markdown Types ==========
+
+// MarkdownPage is one page to convert and publish.
+type MarkdownPage struct {
+	Title    string `json:"title" jsonschema:"Wiki page title to create"`
+	Markdown string `json:"markdown" jsonschema:"Markdown source for the page"`
+}
+
+// PublishMarkdownArgs contains parameters for converting and publishing a
+// batch of Markdown documents.
+type PublishMarkdownArgs struct {
+	BaseWriteArgs
+	Pages     []MarkdownPage `json:"pages" jsonschema:"Pages to convert and create, each with a title and Markdown content (max 50)"`
+	Theme     string         `json:"theme,omitempty" jsonschema:"Color theme: 'tieto', 'neutral' (default), or 'dark'"`
+	Summary   string         `json:"summary,omitempty" jsonschema:"Edit summary for every created page"`
+	Preview   bool           `json:"preview,omitempty" jsonschema:"Convert only and return the wikitext for each page without saving anything"`
+	Overwrite bool           `json:"overwrite,omitempty" jsonschema:"Replace pages that already exist (by default existing pages are left untouched and reported as failures)"`
+}
+
+// PublishMarkdownResult contains per-page results for a batch publish.
+type PublishMarkdownResult struct {
+	Preview      bool            `json:"preview,omitempty"`
+	SuccessCount int             `json:"success_count"`
+	FailureCount int             `json:"failure_count"`
+	Pages        []PublishedPage `json:"pages"`
+}
+
+// PublishedPage reports the outcome for one page.
+type PublishedPage struct {
+	Title      string `json:"title"`
+	Success    bool   `json:"success"`
+	Wikitext   string `json:"wikitext,omitempty"`
+	RevisionID int    `json:"revision_id,omitempty"`
+	PageURL    string `json:"page_url,omitempty"`
+	NewPage    bool   `json:"new_page,omitempty"`
+	DryRun     bool   `json:"dry_run,omitempty"`
+	Error      string `json:"error,omitempty"`
 }
 
 // ========== Manage Categories Types ==========
@@ -206,6 +405,92 @@ type ManageCategoriesArgs struct {
 // flag never silently applies a category edit.
 func (a ManageCategoriesArgs) PreviewEnabled() bool { return previewDefaultTrue(a.Preview) }
 
+// ========== Recategorize Pages Types ==========
+
+// RecategorizePagesArgs contains parameters for moving every member of a
+// category to a new category.
+type RecategorizePagesArgs struct {
+	BaseWriteArgs
+	OldCategory string `json:"old_category" jsonschema:"Current category name (with or without 'Category:' prefix)"`
+	NewCategory string `json:"new_category" jsonschema:"New category name (with or without 'Category:' prefix)"`
+	Preview     *bool  `json:"preview,omitempty" jsonschema:"Preview changes without applying them. Omitted means preview (the safe default): no page is saved and the per-page diff is returned. Set false to apply the changes."`
+	Summary     string `json:"summary,omitempty" jsonschema:"Edit summary (auto-generated if empty)"`
+	Limit       int    `json:"limit,omitempty" jsonschema:"Max member pages to process (default 10, max 50)"`
+}
+
+// PreviewEnabled resolves the tri-state preview flag for RecategorizePages. An
+// omitted flag (nil) means preview: write tools default to a dry run so an unset
+// flag never silently edits every member of a category.
+func (a RecategorizePagesArgs) PreviewEnabled() bool { return previewDefaultTrue(a.Preview) }
+
+// ========== Move Section Types ==========
+
+// MoveSectionArgs contains parameters for moving a section between pages.
+type MoveSectionArgs struct {
+	BaseWriteArgs
+	FromTitle   string `json:"from_title" jsonschema:"Page to take the section from"`
+	FromSection *int   `json:"from_section" jsonschema:"Section index on the source page from get_sections (1+). Required"`
+	ToTitle     string `json:"to_title" jsonschema:"Existing page to move the section to"`
+	Position    string `json:"position,omitempty" jsonschema:"Where to put the section on the target page: 'append' (default) or 'prepend'"`
+	Summary     string `json:"summary,omitempty" jsonschema:"Edit summary for both edits (auto-generated if empty)"`
+}
+
+// MoveSectionResult reports the outcome of a section move. The target page
+// is edited first; if removing the section from the source then fails,
+// Partial is set and the section exists on both pages.
+type MoveSectionResult struct {
+	Success          bool   `json:"success"`
+	Partial          bool   `json:"partial,omitempty"`
+	FromTitle        string `json:"from_title"`
+	ToTitle          string `json:"to_title"`
+	SectionTitle     string `json:"section_title,omitempty"`
+	Position         string `json:"position"`
+	TargetRevisionID int    `json:"target_revision_id,omitempty"`
+	SourceRevisionID int    `json:"source_revision_id,omitempty"`
+	SourceError      string `json:"source_error,omitempty"`
+	Message          string `json:"message"`
+}
+
+// ========== Add Category To Pages Types ==========
+
+// AddCategoryToPagesArgs contains parameters for tagging many pages with one
+// category.
+type AddCategoryToPagesArgs struct {
+	BaseWriteArgs
+	Pages    []string `json:"pages" jsonschema:"Page titles to add to the category (max 50)"`
+	Category string   `json:"category" jsonschema:"Category name to add (with or without 'Category:' prefix)"`
+	Preview  *bool    `json:"preview,omitempty" jsonschema:"Preview changes without applying them. Omitted means preview (the safe default): no page is saved and the pages that would be tagged are listed. Set false to apply."`
+	Summary  string   `json:"summary,omitempty" jsonschema:"Edit summary (auto-generated if empty)"`
+}
+
+// PreviewEnabled resolves the tri-state preview flag for AddCategoryToPages.
+// An omitted flag (nil) means preview: write tools default to a dry run so an
+// unset flag never silently edits a batch of pages.
+func (a AddCategoryToPagesArgs) PreviewEnabled() bool { return previewDefaultTrue(a.Preview) }
+
+// AddCategoryToPagesResult summarizes a batch category tagging run.
+type AddCategoryToPagesResult struct {
+	Success        bool                 `json:"success"`
+	Category       string               `json:"category"`
+	PagesProcessed int                  `json:"pages_processed"`
+	PagesModified  int                  `json:"pages_modified"`
+	PagesSkipped   int                  `json:"pages_skipped"`
+	Preview        bool                 `json:"preview"`
+	Results        []PageCategoryResult `json:"results"`
+	Message        string               `json:"message"`
+}
+
+// PageCategoryResult contains the tagging outcome for a single page.
+// Status is one of "added", "would_add", "already_present", or "error".
+type PageCategoryResult struct {
+	Title      string            `json:"title"`
+	Status     string            `json:"status"`
+	RevisionID int               `json:"revision_id,omitempty"`
+	Revision   *EditRevisionInfo `json:"revision,omitempty"`
+	Undo       *UndoInfo         `json:"undo,omitempty"`
+	Error      string            `json:"error,omitempty"`
+}
+
 // previewDefaultTrue resolves a tri-state preview flag, defaulting to true (a
 // dry run) when the caller omits it. This mirrors excludeCodeBlocks in
 // quality_terminology.go: an omitted safety flag resolves to the safe value.
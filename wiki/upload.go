@@ -2,7 +2,9 @@ package wiki
 
 import (
 	"context"
+	"crypto/sha1" // #nosec G505 -- MediaWiki identifies file content by SHA-1
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -38,17 +40,74 @@ func (c *Client) UploadFile(ctx context.Context, args UploadFileArgs) (UploadFil
 	if err := c.EnsureLoggedIn(ctx); err != nil {
 		return UploadFileResult{}, fmt.Errorf("authentication required for uploads: %w", err)
 	}
-
-	result, err := c.performUpload(ctx, args)
-	if err != nil && strings.Contains(err.Error(), "badtoken") {
-		c.invalidateCSRFToken()
-		result, err = c.performUpload(ctx, args)
+	// An upload creates or overwrites the File: page, so it is an edit to
+	// the File namespace (6) as far as the allowlist is concerned
+	if err := c.checkEditableNamespace(ctx, "File:"+args.Filename); err != nil {
+		return UploadFileResult{}, err
+	}
+	if present, ok := c.identicalFilePresent(ctx, args); ok {
+		return present, nil
 	}
+	if c.config.DryRun {
+		return c.dryRunUpload(args), nil
+	}
+
+	result, err := retryOnBadToken(c, func() (UploadFileResult, error) {
+		return c.performUpload(ctx, args)
+	})
 
 	c.logUploadOutcome(args, result, err)
 	return result, err
 }
 
+// identicalFilePresent reports whether the target file already holds exactly
+// the bytes being uploaded, comparing the SHA-1 MediaWiki stores for the
+// current file revision. Re-running a failed or interrupted upload then
+// returns "already present" instead of stacking a duplicate revision. Only
+// inline bytes can be hashed locally; URL uploads are fetched by the wiki
+// and always proceed. A failed lookup is not fatal: the upload goes ahead
+// and MediaWiki's own duplicate warnings still apply.
+func (c *Client) identicalFilePresent(ctx context.Context, args UploadFileArgs) (UploadFileResult, bool) {
+	if len(args.FileData) == 0 {
+		return UploadFileResult{}, false
+	}
+
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("titles", "File:"+args.Filename)
+	params.Set("prop", "imageinfo")
+	params.Set("iiprop", "sha1|size|url")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		c.logger.Debug("Upload duplicate check failed", "filename", args.Filename, "error", err)
+		return UploadFileResult{}, false
+	}
+
+	sum := sha1.Sum(args.FileData) // #nosec G401 -- matches MediaWiki's stored file hash, not used for security
+	localHash := hex.EncodeToString(sum[:])
+	for _, pageData := range getMap(getMap(resp["query"])["pages"]) {
+		revisions := getSlice(getMap(pageData)["imageinfo"])
+		if len(revisions) == 0 {
+			continue
+		}
+		info := getMap(revisions[0])
+		if !strings.EqualFold(getString(info["sha1"]), localHash) {
+			continue
+		}
+		return UploadFileResult{
+			Success:        true,
+			Filename:       args.Filename,
+			URL:            getString(info["url"]),
+			Size:           getInt(info["size"]),
+			DryRun:         c.config.DryRun,
+			AlreadyPresent: true,
+			Message:        "File already present with identical content; upload skipped",
+		}, true
+	}
+	return UploadFileResult{}, false
+}
+
 // validateUploadArgs enforces required upload inputs.
 func validateUploadArgs(args UploadFileArgs) error {
 	if args.Filename == "" {
@@ -296,6 +355,11 @@ func (c *Client) uploadFromBytes(ctx context.Context, args UploadFileArgs, fileD
 	if err := c.parseJSONResponse(resp, &result); err != nil {
 		return UploadFileResult{}, err
 	}
+	// This request bypasses apiRequest, so surface a rejected token here
+	// for the badtoken retry in UploadFile.
+	if apiErr := responseAPIError(result); apiErr != nil && apiErr.Code == "badtoken" {
+		return UploadFileResult{}, apiErr
+	}
 
 	return c.parseUploadResponse(result, args.Filename)
 }
@@ -368,7 +432,11 @@ func (c *Client) parseJSONResponse(resp *http.Response, target interface{}) erro
 	// hundred bytes of metadata) — multi-megabyte responses signal a wrong-
 	// content-type or attacker-shaped server reply.
 	const maxRespBytes = 1 << 20 // 1 MiB
-	body, err := io.ReadAll(io.LimitReader(resp.Body, maxRespBytes))
+	reader, err := decodeResponseBody(resp)
+	if err != nil {
+		return fmt.Errorf("decode upload response: %w", err)
+	}
+	body, err := io.ReadAll(io.LimitReader(reader, maxRespBytes))
 	if err != nil {
 		return fmt.Errorf("read upload response: %w", err)
 	}
@@ -5,8 +5,10 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 )
@@ -155,6 +157,12 @@ func TestUploadFile_FromBase64_EndToEnd(t *testing.T) {
 	var gotFilename string
 
 	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// The duplicate-content pre-check finds no existing file.
+		if r.FormValue("prop") == "imageinfo" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"query":{"pages":{"-1":{"ns":6,"title":"File:Logo.png","missing":""}}}}`))
+			return
+		}
 		// The upload POST is multipart; the mock wrapper already parsed it via
 		// FormValue, so the file part is available here.
 		file, hdr, err := r.FormFile("file")
@@ -357,3 +365,109 @@ func TestParseJSONResponse_BodyHandling(t *testing.T) {
 		}
 	})
 }
+
+// TestUploadFile_BadTokenRefresh covers the multipart upload path, which
+// bypasses apiRequest: a badtoken reply must invalidate the cached CSRF
+// token, fetch a new one, and retry the upload once.
+func TestUploadFile_BadTokenRefresh(t *testing.T) {
+	var tokenFetches, uploads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("meta") == "userinfo":
+			_, _ = w.Write([]byte(`{"query":{"userinfo":{"id":1,"name":"TestUser"}}}`))
+		case r.FormValue("meta") == "tokens":
+			tokenFetches++
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"tokens": map[string]interface{}{"csrftoken": fmt.Sprintf("token-%d", tokenFetches)},
+				},
+			})
+		case r.FormValue("action") == "upload":
+			uploads++
+			if r.FormValue("token") != "token-2" {
+				_, _ = w.Write([]byte(`{"error":{"code":"badtoken","info":"Invalid CSRF token."}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"upload":{"result":"Success","filename":"Test.png"}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.UploadFile(context.Background(), UploadFileArgs{
+		Filename: "Test.png",
+		FileData: []byte("png bytes"),
+	})
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if !result.Success {
+		t.Errorf("expected success after token refresh, got %+v", result)
+	}
+	if tokenFetches != 2 || uploads != 2 {
+		t.Errorf("token fetches = %d, uploads = %d; want 2 and 2", tokenFetches, uploads)
+	}
+}
+
+// TestUploadFile_SkipsIdenticalContent checks the retry-safety pre-check: when
+// the target file's current SHA-1 matches the bytes being uploaded, nothing is
+// sent and the result reports the file as already present. Different content
+// still uploads.
+func TestUploadFile_SkipsIdenticalContent(t *testing.T) {
+	data := []byte("png bytes")
+	var uploads int
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The wrapper's ParseForm leaves multipart bodies unread; route on
+		// Content-Type as the other byte-upload tests do.
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			uploads++
+			_, _ = w.Write([]byte(`{"upload":{"result":"Success","filename":"Logo.png"}}`))
+			return
+		}
+		if r.FormValue("prop") != "imageinfo" || r.FormValue("titles") != "File:Logo.png" {
+			t.Errorf("unexpected request: %v", r.Form)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"query": map[string]interface{}{
+				"pages": map[string]interface{}{
+					"7": map[string]interface{}{
+						"pageid": float64(7), "ns": float64(6), "title": "File:Logo.png",
+						"imageinfo": []interface{}{map[string]interface{}{
+							// sha1("png bytes")
+							"sha1": "1728e8f7005d77be97c1226a5d1a90f471c1085d",
+							"size": float64(len(data)),
+							"url":  "https://wiki.example.org/images/Logo.png",
+						}},
+					},
+				},
+			},
+		})
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.UploadFile(context.Background(), UploadFileArgs{Filename: "Logo.png", FileData: data})
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+	if uploads != 0 {
+		t.Errorf("uploads = %d, want the identical file to be skipped", uploads)
+	}
+	if !result.Success || !result.AlreadyPresent || result.URL != "https://wiki.example.org/images/Logo.png" {
+		t.Errorf("result = %+v, want success with already_present", result)
+	}
+
+	result, err = client.UploadFile(context.Background(), UploadFileArgs{Filename: "Logo.png", FileData: []byte("new png bytes")})
+	if err != nil {
+		t.Fatalf("UploadFile with changed content: %v", err)
+	}
+	if uploads != 1 || result.AlreadyPresent {
+		t.Errorf("uploads = %d, result = %+v; want changed content to be uploaded", uploads, result)
+	}
+}
@@ -1,7 +1,9 @@
 package wiki
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestNormalizeLimit(t *testing.T) {
@@ -43,7 +45,16 @@ func TestNormalizeCategoryName(t *testing.T) {
 		{"With spaces", "  My Category  ", "Category:My Category"},
 		{"Empty string", "", "Category:"},
 		{"Only prefix", "Category:", "Category:"},
-		{"Lowercase prefix not matched", "category:Test", "Category:category:Test"},
+		{"Lowercase prefix", "category:Test", "Category:Test"},
+		{"Uppercase prefix", "CATEGORY:Test", "Category:Test"},
+		{"Lowercase prefix and name", "category:foo", "Category:Foo"},
+		{"Lowercase name without prefix", "foo bar", "Category:Foo bar"},
+		{"Underscores without prefix", "Foo_Bar", "Category:Foo Bar"},
+		{"Underscores with prefix", "Category:Foo_Bar", "Category:Foo Bar"},
+		{"Space after prefix", "Category: Foo Bar", "Category:Foo Bar"},
+		{"Repeated separators", "Foo__Bar  Baz", "Category:Foo Bar Baz"},
+		{"Non-ASCII first letter", "état_civil", "Category:État civil"},
+		{"Prefix-like text inside name", "Cat:Foo", "Category:Cat:Foo"},
 	}
 
 	for _, tt := range tests {
@@ -118,6 +129,65 @@ func TestTruncateContent(t *testing.T) {
 	}
 }
 
+func TestTruncateContent_MultiByteBoundary(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		limit   int
+		want    string
+	}{
+		// "日" is 3 bytes; a limit of 4 lands inside the second character
+		{"CJK split mid-rune", "日本語テキスト", 4, "日"},
+		{"CJK exact rune boundary", "日本語テキスト", 6, "日本"},
+		// "😀" is 4 bytes; a limit of 6 lands inside the second emoji
+		{"Emoji split mid-rune", "😀😀😀", 6, "😀"},
+		{"ASCII prefix then emoji", "ab😀cd", 3, "ab"},
+		{"Limit inside first rune", "😀😀", 2, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, truncated := truncateContent(tt.content, tt.limit)
+			if !truncated {
+				t.Fatalf("truncateContent(%q, %d) should truncate", tt.content, tt.limit)
+			}
+			if !utf8.ValidString(result) {
+				t.Errorf("truncateContent(%q, %d) produced invalid UTF-8: %q", tt.content, tt.limit, result)
+			}
+			if !strings.HasPrefix(result, tt.want+"\n") {
+				t.Errorf("truncateContent(%q, %d) kept %q, want prefix %q", tt.content, tt.limit, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateAtRuneBoundary(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxBytes int
+		want     string
+	}{
+		{"Under limit unchanged", "héllo", 10, "héllo"},
+		{"Zero limit", "héllo", 0, ""},
+		{"Splits before two-byte rune", "héllo", 2, "h"},
+		{"Keeps whole two-byte rune", "héllo", 3, "hé"},
+		{"Emoji boundary", "a😀b", 4, "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateAtRuneBoundary(tt.input, tt.maxBytes)
+			if got != tt.want {
+				t.Errorf("truncateAtRuneBoundary(%q, %d) = %q, want %q", tt.input, tt.maxBytes, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncateAtRuneBoundary(%q, %d) produced invalid UTF-8", tt.input, tt.maxBytes)
+			}
+		})
+	}
+}
+
 func TestStripHTMLTags(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -12,12 +12,23 @@ func (c *Client) EditPage(ctx context.Context, args EditPageArgs) (EditResult, e
 	if err := validateEditArgs(args); err != nil {
 		return EditResult{}, err
 	}
+	if err := c.checkEditGuardrails(ctx, args); err != nil {
+		return EditResult{}, err
+	}
 
-	editResult, err := c.performEdit(ctx, args)
-	if err != nil && strings.Contains(err.Error(), "badtoken") {
-		c.invalidateCSRFToken()
-		editResult, err = c.performEdit(ctx, args)
+	if args.ValidateFirst {
+		issues, err := c.ValidateWikitext(ctx, args.submittedText(), args.Title)
+		if err != nil {
+			return EditResult{}, fmt.Errorf("wikitext validation failed: %w", err)
+		}
+		if len(issues) > 0 {
+			return EditResult{}, newWikitextIssuesError(issues)
+		}
 	}
+
+	editResult, err := retryOnBadToken(c, func() (EditResult, error) {
+		return c.performEdit(ctx, args, nil)
+	})
 	if err != nil {
 		return EditResult{}, err
 	}
@@ -38,6 +49,19 @@ Example:
   Title: "User:Username/Subpage"`,
 		}
 	}
+	if args.addsText() {
+		if args.Content != "" {
+			return &ValidationError{
+				Field:      "content",
+				Message:    "content cannot be combined with append_text or prepend_text",
+				Suggestion: "Send the full page text in content, or only the text to add in append_text/prepend_text.",
+			}
+		}
+		if err := ValidateContentSize(args.submittedText(), args.Title, MaxEditSize); err != nil {
+			return err
+		}
+		return ValidateWikitextContent(args.submittedText(), args.Title)
+	}
 	if args.Content == "" {
 		return &ValidationError{
 			Field:   "content",
@@ -47,7 +71,8 @@ Example:
 Example:
   Content: "== Section ==\nThis is the page content."
 
-If you want to clear a page, use a single space or redirect instead.`,
+If you want to clear a page, use a single space or redirect instead
+(with allow_blanking set, since blanking a page is refused by default).`,
 		}
 	}
 	if err := ValidateContentSize(args.Content, args.Title, MaxEditSize); err != nil {
@@ -56,13 +81,21 @@ If you want to clear a page, use a single space or redirect instead.`,
 	return ValidateWikitextContent(args.Content, args.Title)
 }
 
-// performEdit executes a single edit attempt with a fresh CSRF token.
 // buildEditAPIParams builds the form parameters for an edit API call.
 func buildEditAPIParams(args EditPageArgs, token string) url.Values {
 	params := url.Values{}
 	params.Set("action", "edit")
 	params.Set("title", args.Title)
-	params.Set("text", args.Content)
+	if args.addsText() {
+		if args.AppendText != "" {
+			params.Set("appendtext", args.AppendText)
+		}
+		if args.PrependText != "" {
+			params.Set("prependtext", args.PrependText)
+		}
+	} else {
+		params.Set("text", args.Content)
+	}
 	params.Set("token", token)
 	if args.Summary != "" {
 		params.Set("summary", args.Summary)
@@ -85,6 +118,9 @@ func buildEditAPIParams(args EditPageArgs, token string) url.Values {
 	if args.BaseTimestamp != "" {
 		params.Set("basetimestamp", args.BaseTimestamp)
 	}
+	if args.CreateOnly {
+		params.Set("createonly", "1")
+	}
 	return params
 }
 
@@ -108,20 +144,36 @@ func (c *Client) editResultFromAPI(ctx context.Context, edit map[string]interfac
 	return r
 }
 
-func (c *Client) performEdit(ctx context.Context, args EditPageArgs) (EditResult, error) {
+// performEdit executes a single edit attempt with a fresh CSRF token.
+// Any values in extra are merged into the edit parameters, letting callers
+// such as EditSection add options (baserevid, nocreate) that EditPageArgs
+// does not expose. Page and section edits funnel through here, so this is
+// where the editable-namespace allowlist is enforced for them; the other
+// writes (moves, deletions, protection, null edits, uploads) check it
+// themselves.
+func (c *Client) performEdit(ctx context.Context, args EditPageArgs, extra url.Values) (EditResult, error) {
+	if err := c.checkEditableNamespace(ctx, args.Title); err != nil {
+		return EditResult{}, err
+	}
+	if c.config.DryRun {
+		return c.dryRunEdit(ctx, args, extra), nil
+	}
+
 	token, err := c.getCSRFToken(ctx)
 	if err != nil {
 		return EditResult{}, fmt.Errorf("authentication failed: %w", err)
 	}
 
-	resp, err := c.apiRequest(ctx, buildEditAPIParams(args, token))
+	params := buildEditAPIParams(args, token)
+	for k, v := range extra {
+		params[k] = v
+	}
+
+	resp, err := c.apiRequest(ctx, params)
 	if err != nil {
 		return EditResult{}, err
 	}
 
-	if errInfo, ok := resp["error"].(map[string]interface{}); ok {
-		return EditResult{}, fmt.Errorf("%s: %s", getString(errInfo["code"]), getString(errInfo["info"]))
-	}
 	edit, ok := resp["edit"].(map[string]interface{})
 	if !ok {
 		return EditResult{}, fmt.Errorf("unexpected API response: missing 'edit' object")
@@ -137,7 +189,7 @@ func (c *Client) performEdit(ctx context.Context, args EditPageArgs) (EditResult
 		op = AuditOpCreate
 	}
 	c.logAudit(c.buildAuditEntry(
-		op, editResult.Title, args.Content, args.Summary,
+		op, editResult.Title, args.submittedText(), args.Summary,
 		args.Minor, args.Bot, true, editResult.PageID, editResult.RevisionID, "",
 	))
 	return editResult, nil
@@ -158,7 +210,7 @@ func (c *Client) failedEditResult(args EditPageArgs, edit map[string]interface{}
 		msg += fmt.Sprintf(" (CAPTCHA: %s)", captchaType)
 	}
 	c.logAudit(c.buildAuditEntry(
-		AuditOpEdit, args.Title, args.Content, args.Summary,
+		AuditOpEdit, args.Title, args.submittedText(), args.Summary,
 		args.Minor, args.Bot, false, 0, 0, msg,
 	))
 	return EditResult{
@@ -137,3 +137,178 @@ func keysOf(m map[string]bool) []string {
 	}
 	return out
 }
+
+// categoryNamePattern returns a regex fragment matching a category name the
+// way MediaWiki resolves it: the first letter is case-insensitive and spaces
+// and underscores are interchangeable.
+func categoryNamePattern(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r == ' ' || r == '_':
+			sb.WriteString(`[ _]+`)
+		case i == 0 && strings.ToUpper(string(r)) != strings.ToLower(string(r)):
+			sb.WriteString("[" + regexp.QuoteMeta(strings.ToUpper(string(r))) + regexp.QuoteMeta(strings.ToLower(string(r))) + "]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return sb.String()
+}
+
+// bareCategoryName strips whitespace and any "Category:" prefix.
+func bareCategoryName(name string) string {
+	return strings.TrimSpace(strings.TrimPrefix(normalizeCategoryName(name), "Category:"))
+}
+
+// categoryTagPattern returns a regex matching [[Category:Name]] and
+// [[Category:Name|sortkey]] tags for the named category. The sort key, with
+// its leading pipe, is captured as group 1.
+func categoryTagPattern(name string) string {
+	return `\[\[[ \t]*[Cc]ategory[ \t]*:[ \t]*` + categoryNamePattern(name) + `[ \t]*(\|[^\]]*)?\]\]`
+}
+
+// buildRecategorizeReplace returns the regex and replacement that rewrite
+// [[Category:Old]] and [[Category:Old|sortkey]] tags to the new category,
+// keeping any sort key.
+func buildRecategorizeReplace(oldCategory, newCategory string) (find, replace string) {
+	find = categoryTagPattern(oldCategory)
+	replace = "[[Category:" + strings.ReplaceAll(newCategory, "$", "$$") + "${1}]]"
+	return find, replace
+}
+
+// RecategorizePages moves every member of OldCategory to NewCategory by
+// rewriting the category tag in each member's wikitext. Sort keys are
+// preserved. Members categorized through a template rather than a literal
+// tag report zero matches and are left untouched.
+func (c *Client) RecategorizePages(ctx context.Context, args RecategorizePagesArgs) (BulkReplaceResult, error) {
+	oldCategory := bareCategoryName(args.OldCategory)
+	newCategory := bareCategoryName(args.NewCategory)
+	if oldCategory == "" {
+		return BulkReplaceResult{}, fmt.Errorf("old_category is required")
+	}
+	if newCategory == "" {
+		return BulkReplaceResult{}, fmt.Errorf("new_category is required")
+	}
+	if oldCategory == newCategory {
+		return BulkReplaceResult{}, fmt.Errorf("old_category and new_category are the same")
+	}
+
+	summary := args.Summary
+	if summary == "" {
+		summary = fmt.Sprintf("Recategorize: [[:Category:%s]] → [[:Category:%s]]", oldCategory, newCategory)
+	}
+
+	find, replace := buildRecategorizeReplace(oldCategory, newCategory)
+	preview := args.PreviewEnabled()
+	return c.BulkReplace(ctx, BulkReplaceArgs{
+		Category: oldCategory,
+		Find:     find,
+		Replace:  replace,
+		UseRegex: true,
+		Preview:  &preview,
+		Summary:  summary,
+		Limit:    args.Limit,
+	})
+}
+
+// AddCategoryToPages appends [[Category:X]] to each listed page that is not
+// already tagged with it. Pages that already carry the tag (in any spelling
+// MediaWiki treats as equivalent) are skipped. A failure on one page is
+// recorded on that page's result and does not stop the rest.
+func (c *Client) AddCategoryToPages(ctx context.Context, args AddCategoryToPagesArgs) (AddCategoryToPagesResult, error) {
+	category := bareCategoryName(args.Category)
+	if category == "" {
+		return AddCategoryToPagesResult{}, fmt.Errorf("category is required")
+	}
+	if len(args.Pages) == 0 {
+		return AddCategoryToPagesResult{}, fmt.Errorf("at least one page is required")
+	}
+	if len(args.Pages) > MaxBatchSize {
+		return AddCategoryToPagesResult{}, NewBatchTooLargeError(len(args.Pages), MaxBatchSize)
+	}
+
+	summary := args.Summary
+	if summary == "" {
+		summary = fmt.Sprintf("Added categories: %s", category)
+	}
+
+	tagRegex := regexp.MustCompile(categoryTagPattern(category))
+	preview := args.PreviewEnabled()
+	result := AddCategoryToPagesResult{
+		Category: category,
+		Preview:  preview,
+		Results:  make([]PageCategoryResult, 0, len(args.Pages)),
+	}
+	for _, title := range args.Pages {
+		pageResult := c.addCategoryToPage(ctx, title, category, tagRegex, summary, preview)
+		switch pageResult.Status {
+		case "added", "would_add":
+			result.PagesModified++
+		case "already_present":
+			result.PagesSkipped++
+		}
+		result.Results = append(result.Results, pageResult)
+	}
+
+	result.PagesProcessed = len(result.Results)
+	result.Success = true
+	if preview {
+		result.Message = fmt.Sprintf("Preview: %d pages would be added to Category:%s, %d already present", result.PagesModified, category, result.PagesSkipped)
+	} else {
+		result.Message = fmt.Sprintf("Added %d pages to Category:%s, %d already present", result.PagesModified, category, result.PagesSkipped)
+	}
+	return result, nil
+}
+
+// addCategoryToPage tags a single page with category unless tagRegex shows
+// it is already present. The tag is appended rather than sent with the page
+// text, and the edit is based on the revision read, so it fails with an
+// edit conflict instead of reverting a change made in the meantime.
+func (c *Client) addCategoryToPage(ctx context.Context, title, category string, tagRegex *regexp.Regexp, summary string, preview bool) PageCategoryResult {
+	pageResult := PageCategoryResult{Title: title}
+	page, err := c.GetPage(ctx, GetPageArgs{Title: title, Format: "wikitext"})
+	if err != nil {
+		pageResult.Status = "error"
+		pageResult.Error = err.Error()
+		return pageResult
+	}
+	pageResult.Title = page.Title
+	if page.Truncated {
+		// The existing tag may sit in the part that was cut off.
+		pageResult.Status = "error"
+		pageResult.Error = fmt.Sprintf("page exceeds %d characters and could not be read in full", CharacterLimit)
+		return pageResult
+	}
+
+	if tagRegex.MatchString(page.Content) {
+		pageResult.Status = "already_present"
+		return pageResult
+	}
+	if preview {
+		pageResult.Status = "would_add"
+		return pageResult
+	}
+
+	editResult, err := c.EditPage(ctx, EditPageArgs{
+		Title:         page.Title,
+		AppendText:    "\n[[Category:" + category + "]]",
+		BaseTimestamp: page.Timestamp,
+		Summary:       summary,
+		Minor:         true,
+	})
+	if err != nil {
+		pageResult.Status = "error"
+		pageResult.Error = err.Error()
+		return pageResult
+	}
+	if !editResult.Success {
+		pageResult.Status = "error"
+		pageResult.Error = editResult.Message
+		return pageResult
+	}
+	pageResult.Status = "added"
+	pageResult.RevisionID = editResult.RevisionID
+	pageResult.Revision, pageResult.Undo = c.buildEditRevisionInfo(page.Title, page.Revision, editResult.RevisionID)
+	return pageResult
+}
@@ -3,6 +3,7 @@ package wiki
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"sort"
@@ -291,3 +292,317 @@ func TestManageCategories_NoAddOrRemove(t *testing.T) {
 		t.Error("expected error when neither add nor remove is specified")
 	}
 }
+
+func TestBuildRecategorizeReplace(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"plain tag", "Body\n[[Category:Old Docs]]", "Body\n[[Category:New Docs]]"},
+		{"sort key preserved", "[[Category:Old Docs|Zebra]]", "[[Category:New Docs|Zebra]]"},
+		{"empty sort key preserved", "[[Category:Old Docs|]]", "[[Category:New Docs|]]"},
+		{"underscores and lowercase first letter", "[[category:old_Docs|*]]", "[[Category:New Docs|*]]"},
+		{"other categories untouched", "[[Category:Old Docs Archive]]\n[[Category:Other]]", "[[Category:Old Docs Archive]]\n[[Category:Other]]"},
+	}
+
+	find, replace := buildRecategorizeReplace("Old Docs", "New Docs")
+	re, err := compileFindReplaceRegex(find, true)
+	if err != nil {
+		t.Fatalf("compileFindReplaceRegex: %v", err)
+	}
+	op := findReplaceOp{re: re, replace: replace, all: true}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, _, _ := applyFindReplaceToContent(tt.content, op)
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// recategorizeMockServer serves one category member with the given content
+// and records the text of any submitted edit in edited.
+func recategorizeMockServer(t *testing.T, pageContent string, edited *string) *httptest.Server {
+	t.Helper()
+	return mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("list") == "categorymembers":
+			response := map[string]interface{}{
+				"query": map[string]interface{}{
+					"categorymembers": []interface{}{
+						map[string]interface{}{"pageid": float64(123), "title": "Test Page"},
+					},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		case r.FormValue("action") == "query":
+			response := map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"123": map[string]interface{}{
+							"pageid":    float64(123),
+							"title":     "Test Page",
+							"lastrevid": float64(100),
+							"revisions": []interface{}{
+								map[string]interface{}{
+									"timestamp": "2026-10-01T12:00:00Z",
+									"slots": map[string]interface{}{
+										"main": map[string]interface{}{"content": pageContent},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		case r.FormValue("action") == "edit":
+			*edited = r.FormValue("text")
+			if appended := r.FormValue("appendtext"); appended != "" {
+				*edited = "append:" + appended + " base:" + r.FormValue("basetimestamp")
+			}
+			response := map[string]interface{}{
+				"edit": map[string]interface{}{
+					"result":   "Success",
+					"pageid":   float64(123),
+					"title":    "Test Page",
+					"newrevid": float64(101),
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func TestRecategorizePages_Preview(t *testing.T) {
+	edited := ""
+	server := recategorizeMockServer(t, "Body\n[[Category:Old|Key]]\n", &edited)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.RecategorizePages(context.Background(), RecategorizePagesArgs{
+		OldCategory: "Category:Old",
+		NewCategory: "New",
+	})
+	if err != nil {
+		t.Fatalf("RecategorizePages failed: %v", err)
+	}
+	if !result.Preview {
+		t.Error("expected preview when preview is omitted")
+	}
+	if edited != "" {
+		t.Errorf("edit submitted in preview mode: %q", edited)
+	}
+	if result.PagesModified != 1 || len(result.Results) != 1 {
+		t.Fatalf("result = %+v, want one page to be modified", result)
+	}
+	changes := result.Results[0].Changes
+	if len(changes) != 1 || changes[0].After != "[[Category:New|Key]]" {
+		t.Errorf("changes = %+v, want the tag rewritten with its sort key", changes)
+	}
+}
+
+func TestRecategorizePages_Apply(t *testing.T) {
+	edited := ""
+	server := recategorizeMockServer(t, "Body\n[[Category:Old|Key]]\n[[Category:Keep]]\n", &edited)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.RecategorizePages(context.Background(), RecategorizePagesArgs{
+		OldCategory: "Old",
+		NewCategory: "New",
+		Preview:     boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("RecategorizePages failed: %v", err)
+	}
+	if result.PagesModified != 1 {
+		t.Errorf("PagesModified = %d, want 1", result.PagesModified)
+	}
+	want := "Body\n[[Category:New|Key]]\n[[Category:Keep]]\n"
+	if edited != want {
+		t.Errorf("edited text = %q, want %q", edited, want)
+	}
+}
+
+func TestRecategorizePages_Validation(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	tests := []struct {
+		name string
+		args RecategorizePagesArgs
+	}{
+		{"missing old", RecategorizePagesArgs{NewCategory: "New"}},
+		{"missing new", RecategorizePagesArgs{OldCategory: "Old"}},
+		{"same category", RecategorizePagesArgs{OldCategory: "Category:Old", NewCategory: "Old"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.RecategorizePages(context.Background(), tt.args); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
+
+func TestAddCategoryToPages_AlreadyPresentSkipped(t *testing.T) {
+	edited := ""
+	server := recategorizeMockServer(t, "Body\n[[category:team_docs|Key]]\n", &edited)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{
+		Pages:    []string{"Test Page"},
+		Category: "Category:Team docs",
+		Preview:  boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("AddCategoryToPages failed: %v", err)
+	}
+	if edited != "" {
+		t.Errorf("page was edited although already categorized: %q", edited)
+	}
+	if result.PagesSkipped != 1 || result.PagesModified != 0 {
+		t.Errorf("skipped=%d modified=%d, want 1 and 0", result.PagesSkipped, result.PagesModified)
+	}
+	if len(result.Results) != 1 || result.Results[0].Status != "already_present" {
+		t.Errorf("Results = %+v, want one already_present entry", result.Results)
+	}
+}
+
+func TestAddCategoryToPages_Preview(t *testing.T) {
+	edited := ""
+	server := recategorizeMockServer(t, "Body\n[[Category:Other]]\n", &edited)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{
+		Pages:    []string{"Test Page"},
+		Category: "Team docs",
+	})
+	if err != nil {
+		t.Fatalf("AddCategoryToPages failed: %v", err)
+	}
+	if !result.Preview {
+		t.Error("expected preview to be the default")
+	}
+	if edited != "" {
+		t.Errorf("preview should not edit, got %q", edited)
+	}
+	if len(result.Results) != 1 || result.Results[0].Status != "would_add" {
+		t.Errorf("Results = %+v, want one would_add entry", result.Results)
+	}
+}
+
+func TestAddCategoryToPages_Apply(t *testing.T) {
+	edited := ""
+	server := recategorizeMockServer(t, "Body\n[[Category:Other]]", &edited)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{
+		Pages:    []string{"Test Page"},
+		Category: "Team docs",
+		Preview:  boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("AddCategoryToPages failed: %v", err)
+	}
+	if want := "append:\n[[Category:Team docs]] base:2026-10-01T12:00:00Z"; edited != want {
+		t.Errorf("edit = %q, want %q (the tag appended, based on the read revision)", edited, want)
+	}
+	if result.PagesModified != 1 || result.Results[0].Status != "added" || result.Results[0].RevisionID != 101 {
+		t.Errorf("result = %+v, want one added page at revision 101", result)
+	}
+}
+
+func TestAddCategoryToPages_TruncatedPageRefused(t *testing.T) {
+	edited := ""
+	server := recategorizeMockServer(t, strings.Repeat("x", CharacterLimit+1), &edited)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{
+		Pages:    []string{"Test Page"},
+		Category: "Team docs",
+		Preview:  boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("AddCategoryToPages failed: %v", err)
+	}
+	if edited != "" {
+		t.Errorf("truncated page was edited: %q", edited)
+	}
+	if r := result.Results[0]; r.Status != "error" || !strings.Contains(r.Error, "could not be read in full") {
+		t.Errorf("result = %+v, want the truncated page refused", r)
+	}
+}
+
+func TestAddCategoryToPages_EditConflict(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") == "edit" {
+			_, _ = w.Write([]byte(`{"error":{"code":"editconflict","info":"Edit conflict."}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"query":{"pages":{"123":{"pageid":123,"title":"Test Page","lastrevid":100,` +
+			`"revisions":[{"timestamp":"2026-10-01T12:00:00Z","slots":{"main":{"content":"Body"}}}]}}}}`))
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{
+		Pages:    []string{"Test Page"},
+		Category: "Team docs",
+		Preview:  boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("AddCategoryToPages failed: %v", err)
+	}
+	if result.PagesModified != 0 {
+		t.Errorf("PagesModified = %d, want 0", result.PagesModified)
+	}
+	if r := result.Results[0]; r.Status != "error" || !strings.Contains(r.Error, "editconflict") {
+		t.Errorf("result = %+v, want an editconflict error", r)
+	}
+}
+
+func TestAddCategoryToPages_Validation(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	if _, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{Pages: []string{"A"}}); err == nil {
+		t.Error("expected error for missing category")
+	}
+	if _, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{Category: "X"}); err == nil {
+		t.Error("expected error for missing pages")
+	}
+	tooMany := make([]string, MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("Page %d", i)
+	}
+	if _, err := client.AddCategoryToPages(context.Background(), AddCategoryToPagesArgs{Pages: tooMany, Category: "X"}); err == nil {
+		t.Error("expected error for too many pages")
+	}
+}
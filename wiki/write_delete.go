@@ -0,0 +1,235 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DeletePage deletes a wiki page and all its revisions. The revisions are
+// counted first, which also turns a missing page into a page-not-found
+// error instead of a bare "missingtitle".
+func (c *Client) DeletePage(ctx context.Context, args DeletePageArgs) (DeletePageResult, error) {
+	if args.Title == "" {
+		return DeletePageResult{}, &ValidationError{
+			Field:   "title",
+			Message: "page title is required",
+		}
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return DeletePageResult{}, fmt.Errorf("authentication required for page deletion: %w", err)
+	}
+	if err := c.checkEditableNamespace(ctx, args.Title); err != nil {
+		return DeletePageResult{}, err
+	}
+
+	revisions, capped, err := c.countPageRevisions(ctx, args.Title)
+	if err != nil {
+		return DeletePageResult{}, err
+	}
+	if c.config.DryRun {
+		return c.dryRunDelete(args, revisions, capped), nil
+	}
+
+	resp, err := retryOnBadToken(c, func() (map[string]interface{}, error) {
+		token, err := c.getCSRFToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+		params := url.Values{}
+		params.Set("action", "delete")
+		params.Set("title", args.Title)
+		params.Set("token", token)
+		if args.Reason != "" {
+			params.Set("reason", args.Reason)
+		}
+		return c.apiRequest(ctx, params)
+	})
+	if err != nil {
+		if IsAPIErrorCode(err, "permissiondenied") {
+			return DeletePageResult{}, NewPermissionDeniedError("deleting pages", "delete")
+		}
+		return DeletePageResult{}, err
+	}
+
+	deleteData, ok := resp["delete"].(map[string]interface{})
+	if !ok {
+		return DeletePageResult{
+			Success: false,
+			Title:   args.Title,
+			Message: "Unexpected response format",
+		}, nil
+	}
+
+	result := DeletePageResult{
+		Success:          true,
+		Title:            getString(deleteData["title"]),
+		Reason:           args.Reason,
+		LogID:            getInt(deleteData["logid"]),
+		RevisionsDeleted: revisions,
+		RevisionsCapped:  capped,
+	}
+	if result.Title == "" {
+		result.Title = args.Title
+	}
+	result.Message = fmt.Sprintf("Page '%s' deleted (%s)", result.Title, describeRevisionCount(revisions, capped))
+
+	c.logAudit(AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Operation: AuditOpDelete,
+		Title:     result.Title,
+		Summary:   args.Reason,
+		WikiURL:   c.config.BaseURL,
+		Success:   true,
+	})
+
+	return result, nil
+}
+
+// maxDeleteRevisionCount stops counting a page's revisions after this many,
+// so deleting a page with a vast history does not wait on hundreds of
+// revision queries first.
+const maxDeleteRevisionCount = 50000
+
+// countPageRevisions returns how many revisions a page has, following the
+// API's continuation. capped is true when counting stopped at
+// maxDeleteRevisionCount. A missing page is reported as not found.
+func (c *Client) countPageRevisions(ctx context.Context, title string) (count int, capped bool, err error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("titles", title)
+	params.Set("prop", "revisions")
+	params.Set("rvprop", "ids")
+	params.Set("rvlimit", "max")
+
+	for {
+		resp, err := c.apiRequest(ctx, params)
+		if err != nil {
+			return count, false, err
+		}
+		query, ok := resp["query"].(map[string]interface{})
+		if !ok {
+			return count, false, fmt.Errorf("unexpected API response: missing 'query' object")
+		}
+		found := false
+		for _, pageData := range getMap(query["pages"]) {
+			page := getMap(pageData)
+			if _, missing := page["missing"]; missing {
+				return 0, false, NewPageNotFoundError(title)
+			}
+			count += len(getSlice(page["revisions"]))
+			found = true
+			break
+		}
+		if !found {
+			return 0, false, NewPageNotFoundError(title)
+		}
+		if count >= maxDeleteRevisionCount {
+			return count, true, nil
+		}
+		cont := getString(getMap(resp["continue"])["rvcontinue"])
+		if cont == "" {
+			return count, false, nil
+		}
+		params.Set("rvcontinue", cont)
+	}
+}
+
+// describeRevisionCount renders a revision count for result messages,
+// as "at least N" when counting was capped.
+func describeRevisionCount(count int, capped bool) string {
+	if capped {
+		return fmt.Sprintf("at least %d revisions", count)
+	}
+	return fmt.Sprintf("%d revisions", count)
+}
+
+// UndeletePage restores a deleted page. With Timestamps set only those
+// deleted revisions are restored; otherwise all of them are.
+func (c *Client) UndeletePage(ctx context.Context, args UndeletePageArgs) (UndeletePageResult, error) {
+	if args.Title == "" {
+		return UndeletePageResult{}, &ValidationError{
+			Field:   "title",
+			Message: "page title is required",
+		}
+	}
+	for _, ts := range args.Timestamps {
+		if _, err := time.Parse(time.RFC3339, ts); err != nil {
+			return UndeletePageResult{}, &ValidationError{
+				Field:      "timestamps",
+				Value:      ts,
+				Message:    "timestamps must be ISO 8601 revision timestamps",
+				Suggestion: "Use the timestamps reported by mediawiki_get_deleted_revisions, such as \"2026-10-01T12:00:00Z\".",
+			}
+		}
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return UndeletePageResult{}, fmt.Errorf("authentication required for page restore: %w", err)
+	}
+	if err := c.checkEditableNamespace(ctx, args.Title); err != nil {
+		return UndeletePageResult{}, err
+	}
+	if c.config.DryRun {
+		return c.dryRunUndelete(args), nil
+	}
+
+	resp, err := retryOnBadToken(c, func() (map[string]interface{}, error) {
+		token, err := c.getCSRFToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+		params := url.Values{}
+		params.Set("action", "undelete")
+		params.Set("title", args.Title)
+		params.Set("token", token)
+		if args.Reason != "" {
+			params.Set("reason", args.Reason)
+		}
+		if len(args.Timestamps) > 0 {
+			params.Set("timestamps", strings.Join(args.Timestamps, "|"))
+		}
+		return c.apiRequest(ctx, params)
+	})
+	if err != nil {
+		if IsAPIErrorCode(err, "permissiondenied") {
+			return UndeletePageResult{}, NewPermissionDeniedError("restoring deleted pages", "undelete")
+		}
+		return UndeletePageResult{}, err
+	}
+
+	undeleteData, ok := resp["undelete"].(map[string]interface{})
+	if !ok {
+		return UndeletePageResult{
+			Success: false,
+			Title:   args.Title,
+			Message: "Unexpected response format",
+		}, nil
+	}
+
+	result := UndeletePageResult{
+		Success:           true,
+		Title:             getString(undeleteData["title"]),
+		Reason:            args.Reason,
+		RevisionsRestored: getInt(undeleteData["revisions"]),
+		FilesRestored:     getInt(undeleteData["fileversions"]),
+	}
+	if result.Title == "" {
+		result.Title = args.Title
+	}
+	result.Message = fmt.Sprintf("Page '%s' restored (%d revisions)", result.Title, result.RevisionsRestored)
+
+	c.logAudit(AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Operation: AuditOpUndelete,
+		Title:     result.Title,
+		Summary:   args.Reason,
+		WikiURL:   c.config.BaseURL,
+		Success:   true,
+	})
+
+	return result, nil
+}
@@ -0,0 +1,178 @@
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDeletePage_Success(t *testing.T) {
+	var sawReason string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "query":
+			_, _ = w.Write([]byte(`{"query":{"pages":{"7":{"pageid":7,"title":"Old Page","revisions":[{"revid":3},{"revid":2},{"revid":1}]}}}}`))
+		case "delete":
+			sawReason = r.FormValue("reason")
+			_, _ = w.Write([]byte(`{"delete":{"title":"Old Page","reason":"Obsolete","logid":42}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+	var audit bytes.Buffer
+	client.SetAuditLogger(NewWriterAuditLogger(&audit, client.logger))
+
+	result, err := client.DeletePage(context.Background(), DeletePageArgs{Title: "Old Page", Reason: "Obsolete"})
+	if err != nil {
+		t.Fatalf("DeletePage failed: %v", err)
+	}
+	if !result.Success || result.Title != "Old Page" || result.LogID != 42 {
+		t.Errorf("result = %+v, want success for Old Page with log ID 42", result)
+	}
+	if result.RevisionsDeleted != 3 {
+		t.Errorf("RevisionsDeleted = %d, want 3", result.RevisionsDeleted)
+	}
+	if sawReason != "Obsolete" {
+		t.Errorf("reason = %q, want Obsolete", sawReason)
+	}
+
+	var entry AuditEntry
+	if err := json.Unmarshal(audit.Bytes(), &entry); err != nil {
+		t.Fatalf("audit entry: %v (%q)", err, audit.String())
+	}
+	if entry.Operation != AuditOpDelete || entry.Title != "Old Page" {
+		t.Errorf("audit entry = %+v, want a delete of Old Page", entry)
+	}
+}
+
+func TestDeletePage_FollowsRevisionContinuation(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.FormValue("action") == "delete":
+			_, _ = w.Write([]byte(`{"delete":{"title":"Old Page","logid":43}}`))
+		case r.FormValue("rvcontinue") == "":
+			_, _ = w.Write([]byte(`{"continue":{"rvcontinue":"20261001|2","continue":"||"},` +
+				`"query":{"pages":{"7":{"pageid":7,"title":"Old Page","revisions":[{"revid":5},{"revid":4},{"revid":3}]}}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"query":{"pages":{"7":{"pageid":7,"title":"Old Page","revisions":[{"revid":2},{"revid":1}]}}}}`))
+		}
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.DeletePage(context.Background(), DeletePageArgs{Title: "Old Page"})
+	if err != nil {
+		t.Fatalf("DeletePage failed: %v", err)
+	}
+	if result.RevisionsDeleted != 5 || result.RevisionsCapped {
+		t.Errorf("RevisionsDeleted = %d (capped %v), want all 5 counted", result.RevisionsDeleted, result.RevisionsCapped)
+	}
+	if !strings.Contains(result.Message, "(5 revisions)") {
+		t.Errorf("Message = %q, want the full count", result.Message)
+	}
+}
+
+func TestDeletePage_MissingPage(t *testing.T) {
+	deleted := false
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") == "delete" {
+			deleted = true
+		}
+		_, _ = w.Write([]byte(`{"query":{"pages":{"-1":{"title":"Nope","missing":""}}}}`))
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.DeletePage(context.Background(), DeletePageArgs{Title: "Nope"})
+	var wikiErr *WikiError
+	if !errors.As(err, &wikiErr) || wikiErr.Code != "page_not_found" {
+		t.Fatalf("error = %v, want page_not_found", err)
+	}
+	if deleted {
+		t.Error("delete was sent for a missing page")
+	}
+}
+
+func TestDeletePage_PermissionDenied(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") == "delete" {
+			_, _ = w.Write([]byte(`{"error":{"code":"permissiondenied","info":"You don't have permission to delete pages."}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"query":{"pages":{"7":{"pageid":7,"title":"Old Page","revisions":[{"revid":1}]}}}}`))
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.DeletePage(context.Background(), DeletePageArgs{Title: "Old Page"})
+	if err == nil || !strings.Contains(err.Error(), "'delete' right") {
+		t.Errorf("error = %v, want a permission error naming the delete right", err)
+	}
+}
+
+func TestUndeletePage_Success(t *testing.T) {
+	var sawTimestamps string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") != "undelete" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sawTimestamps = r.FormValue("timestamps")
+		_, _ = w.Write([]byte(`{"undelete":{"title":"Old Page","revisions":2,"fileversions":0,"reason":"Restored"}}`))
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+	var audit bytes.Buffer
+	client.SetAuditLogger(NewWriterAuditLogger(&audit, client.logger))
+
+	result, err := client.UndeletePage(context.Background(), UndeletePageArgs{
+		Title:      "Old Page",
+		Reason:     "Restored",
+		Timestamps: []string{"2026-10-01T10:00:00Z", "2026-10-02T10:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("UndeletePage failed: %v", err)
+	}
+	if !result.Success || result.RevisionsRestored != 2 {
+		t.Errorf("result = %+v, want success with 2 revisions restored", result)
+	}
+	if sawTimestamps != "2026-10-01T10:00:00Z|2026-10-02T10:00:00Z" {
+		t.Errorf("timestamps = %q, want both joined with |", sawTimestamps)
+	}
+	if !strings.Contains(audit.String(), `"operation":"undelete"`) {
+		t.Errorf("audit log = %q, want an undelete entry", audit.String())
+	}
+}
+
+func TestUndeletePage_Validation(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	for name, args := range map[string]UndeletePageArgs{
+		"empty title":   {},
+		"bad timestamp": {Title: "Old Page", Timestamps: []string{"yesterday"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.UndeletePage(context.Background(), args)
+			if _, ok := err.(*ValidationError); !ok {
+				t.Errorf("error = %T %v, want *ValidationError", err, err)
+			}
+		})
+	}
+}
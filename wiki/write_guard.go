@@ -0,0 +1,220 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// checkEditableNamespace refuses a write to title when
+// Config.EditableNamespaces is set and the title's namespace is not in it.
+// Every write path calls it, including uploads, which count as writes to
+// the File namespace (6).
+// The namespace prefix is resolved against the wiki's own namespace names
+// and aliases; if they cannot be fetched the write is refused rather than
+// let through unchecked.
+func (c *Client) checkEditableNamespace(ctx context.Context, title string) error {
+	allowed := c.config.EditableNamespaces
+	if len(allowed) == 0 {
+		return nil
+	}
+	namespace, err := c.titleNamespace(ctx, title)
+	if err != nil {
+		return fmt.Errorf("failed to check the namespace of '%s': %w", title, err)
+	}
+	if slices.Contains(allowed, namespace) {
+		return nil
+	}
+	ids := make([]string, len(allowed))
+	for i, id := range allowed {
+		ids[i] = strconv.Itoa(id)
+	}
+	return &ValidationError{
+		Field:      "title",
+		Value:      title,
+		Message:    fmt.Sprintf("'%s' is in namespace %d, which this server is not allowed to edit", title, namespace),
+		Suggestion: fmt.Sprintf("Only pages in namespaces %s can be changed (MEDIAWIKI_EDITABLE_NAMESPACES). Ask the wiki operator to make this change, or to extend the allowlist.", strings.Join(ids, ", ")),
+	}
+}
+
+// titleNamespace returns the namespace ID of title. A prefix before the
+// first colon that is not a namespace name (as in "Star Wars: Andor")
+// leaves the title in the main namespace.
+func (c *Client) titleNamespace(ctx context.Context, title string) (int, error) {
+	prefix, _, found := strings.Cut(strings.TrimPrefix(strings.TrimSpace(title), ":"), ":")
+	if !found {
+		return 0, nil
+	}
+	namespaces, err := c.GetNamespaces(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return namespaces[namespaceKey(prefix)], nil
+}
+
+// checkEditGuardrails enforces the edit safety limits before an edit
+// reaches the wiki: the operator-configured size limits
+// (Config.MaxEditSizeBytes and Config.MaxEditDeltaBytes), which catch runaway
+// transformations such as a replacement that duplicates the page, and the
+// blanking guard, which refuses edits that remove most of a page unless
+// args.AllowBlanking is set. The checks against the current revision only
+// apply to whole-page edits; section content says nothing about the full
+// page size.
+func (c *Client) checkEditGuardrails(ctx context.Context, args EditPageArgs) error {
+	if args.addsText() {
+		return c.checkAddedTextGuardrails(ctx, args)
+	}
+	if limit := c.config.MaxEditSizeBytes; limit > 0 && len(args.Content) > limit {
+		return &ValidationError{
+			Field:      "content",
+			Value:      fmt.Sprintf("%d bytes", len(args.Content)),
+			Message:    fmt.Sprintf("new content for '%s' exceeds the edit size limit of %d bytes", args.Title, limit),
+			Suggestion: "Check the edit for accidental duplication. If the page really needs to be this large, raise MEDIAWIKI_MAX_EDIT_SIZE_BYTES.",
+		}
+	}
+
+	checkDelta := c.config.MaxEditDeltaBytes > 0
+	if args.Section != "" || (!checkDelta && args.AllowBlanking) {
+		return nil
+	}
+	oldSize, exists, err := c.currentPageLength(ctx, args.Title)
+	if err != nil {
+		if checkDelta {
+			return fmt.Errorf("failed to check edit size delta: %w", err)
+		}
+		c.logger.Warn("Blanking guard skipped: current page size unavailable", "title", args.Title, "error", err)
+		return nil
+	}
+	if !exists {
+		return nil
+	}
+	if !args.AllowBlanking {
+		if err := checkBlanking(args, oldSize, c.config.blankingThreshold()); err != nil {
+			return err
+		}
+	}
+	if checkDelta {
+		return checkEditDelta(args, oldSize, c.config.MaxEditDeltaBytes)
+	}
+	return nil
+}
+
+// checkAddedTextGuardrails applies the size limits to an append or prepend
+// edit. Adding text cannot blank a page, and its length is exactly how much
+// the page grows, so the delta limit applies to the added bytes alone. The
+// size limit applies to the page as it will be after the edit, so the
+// current size is looked up only when that limit is set.
+func (c *Client) checkAddedTextGuardrails(ctx context.Context, args EditPageArgs) error {
+	field := "append_text"
+	if args.PrependText != "" {
+		field = "prepend_text"
+	}
+	added := len(args.submittedText())
+	if limit := c.config.MaxEditDeltaBytes; limit > 0 && added > limit {
+		return &ValidationError{
+			Field:      field,
+			Value:      fmt.Sprintf("%d bytes", added),
+			Message:    fmt.Sprintf("edit to '%s' adds %d bytes, exceeding the edit delta limit of %d bytes", args.Title, added, limit),
+			Suggestion: "Split the addition into smaller edits, or raise MEDIAWIKI_MAX_EDIT_DELTA_BYTES.",
+		}
+	}
+
+	limit := c.config.MaxEditSizeBytes
+	if limit <= 0 {
+		return nil
+	}
+	oldSize, _, err := c.currentPageLength(ctx, args.Title)
+	if err != nil {
+		return fmt.Errorf("failed to check edit size: %w", err)
+	}
+	if newSize := oldSize + added; newSize > limit {
+		return &ValidationError{
+			Field:      field,
+			Value:      fmt.Sprintf("%d bytes", newSize),
+			Message:    fmt.Sprintf("adding %d bytes to '%s' (%d bytes) exceeds the edit size limit of %d bytes", added, args.Title, oldSize, limit),
+			Suggestion: "Check the edit for accidental duplication, or raise MEDIAWIKI_MAX_EDIT_SIZE_BYTES.",
+		}
+	}
+	return nil
+}
+
+// checkBlanking refuses an edit that leaves only whitespace or removes more
+// than thresholdPercent of the page's current size.
+func checkBlanking(args EditPageArgs, oldSize, thresholdPercent int) error {
+	if oldSize == 0 {
+		return nil
+	}
+	newSize := len(args.Content)
+	var message string
+	switch {
+	case strings.TrimSpace(args.Content) == "":
+		message = fmt.Sprintf("edit would blank '%s' (%d bytes)", args.Title, oldSize)
+	case (oldSize-newSize)*100 > oldSize*thresholdPercent:
+		message = fmt.Sprintf("edit would shrink '%s' from %d to %d bytes, removing more than %d%% of the page",
+			args.Title, oldSize, newSize, thresholdPercent)
+	default:
+		return nil
+	}
+	return &ValidationError{
+		Field:      "content",
+		Value:      fmt.Sprintf("%d → %d bytes", oldSize, newSize),
+		Message:    message + "; refused by the blanking guard",
+		Suggestion: "Make sure the content is the full page text, not just the part you changed (use edit_section for a single section). If removing the content is intended, retry with allow_blanking set.",
+	}
+}
+
+// checkEditDelta refuses an edit that grows or shrinks the page by more
+// than limit bytes.
+func checkEditDelta(args EditPageArgs, oldSize, limit int) error {
+	newSize := len(args.Content)
+	delta := newSize - oldSize
+	if delta < 0 {
+		delta = -delta
+	}
+	if delta <= limit {
+		return nil
+	}
+	return &ValidationError{
+		Field:      "content",
+		Value:      fmt.Sprintf("%d → %d bytes", oldSize, newSize),
+		Message:    fmt.Sprintf("edit to '%s' changes the page by %d bytes, exceeding the edit delta limit of %d bytes", args.Title, delta, limit),
+		Suggestion: "Check the edit for accidental duplication or deletion. Split large rewrites into smaller edits, or raise MEDIAWIKI_MAX_EDIT_DELTA_BYTES.",
+	}
+}
+
+// currentPageLength returns the byte length of the page's current revision.
+// It bypasses the page info cache so the comparison always uses the latest
+// revision; exists is false for missing pages.
+func (c *Client) currentPageLength(ctx context.Context, title string) (length int, exists bool, err error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("titles", title)
+	params.Set("prop", "info")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return 0, false, err
+	}
+	query, ok := resp["query"].(map[string]interface{})
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected API response: missing 'query' object")
+	}
+	pages, ok := query["pages"].(map[string]interface{})
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected API response: missing 'pages' object")
+	}
+	for _, pageData := range pages {
+		page, ok := pageData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, missing := page["missing"]; missing {
+			return 0, false, nil
+		}
+		return getInt(page["length"]), true, nil
+	}
+	return 0, false, fmt.Errorf("page '%s' not found in API response", title)
+}
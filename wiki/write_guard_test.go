@@ -0,0 +1,308 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// createGuardMockServer serves a single page whose current revision holds
+// content (and reports its byte length via prop=info), counting edit calls.
+func createGuardMockServer(t *testing.T, content string, edits *atomic.Int32) *Client {
+	t.Helper()
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "query":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"1": map[string]interface{}{
+							"pageid": float64(1),
+							"title":  r.FormValue("titles"),
+							"length": float64(len(content)),
+							"revisions": []interface{}{
+								map[string]interface{}{
+									"revid": float64(100),
+									"slots": map[string]interface{}{"main": map[string]interface{}{"content": content}},
+								},
+							},
+						},
+					},
+				},
+			})
+		case "edit":
+			edits.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"edit": map[string]interface{}{
+					"result":   "Success",
+					"pageid":   float64(1),
+					"title":    r.FormValue("title"),
+					"newrevid": float64(101),
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	t.Cleanup(server.Close)
+	return createMockClient(t, server)
+}
+
+func TestEditPage_MaxEditSizeBytes(t *testing.T) {
+	var edits atomic.Int32
+	client := createGuardMockServer(t, "short", &edits)
+	client.config.MaxEditSizeBytes = 10
+
+	_, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", Content: strings.Repeat("x", 11)})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || !strings.Contains(vErr.Message, "edit size limit of 10 bytes") {
+		t.Fatalf("EditPage error = %v, want edit size limit ValidationError", err)
+	}
+
+	if _, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", Content: strings.Repeat("x", 10)}); err != nil {
+		t.Fatalf("EditPage at the limit: %v", err)
+	}
+	if n := edits.Load(); n != 1 {
+		t.Errorf("edit calls = %d, want 1", n)
+	}
+}
+
+func TestEditSection_MaxEditSizeBytes(t *testing.T) {
+	var edits atomic.Int32
+	client := createGuardMockServer(t, "short", &edits)
+	client.config.MaxEditSizeBytes = 20
+	section := 1
+
+	_, err := client.EditSection(context.Background(), EditSectionArgs{Title: "Page", Section: &section, Content: "== A ==\n" + strings.Repeat("x", 20)})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || !strings.Contains(vErr.Message, "edit size limit of 20 bytes") {
+		t.Fatalf("EditSection error = %v, want edit size limit ValidationError", err)
+	}
+
+	if _, err := client.EditSection(context.Background(), EditSectionArgs{Title: "Page", Section: &section, Content: "== A ==\nsmall"}); err != nil {
+		t.Fatalf("EditSection under the limit: %v", err)
+	}
+	if n := edits.Load(); n != 1 {
+		t.Errorf("edit calls = %d, want 1", n)
+	}
+}
+
+func TestEditPage_AppendTextGuardrails(t *testing.T) {
+	var edits atomic.Int32
+	client := createGuardMockServer(t, strings.Repeat("a", 90), &edits)
+	client.config.MaxEditSizeBytes = 100
+	client.config.MaxEditDeltaBytes = 20
+
+	_, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", AppendText: strings.Repeat("b", 11)})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || !strings.Contains(vErr.Message, "edit size limit of 100 bytes") {
+		t.Fatalf("append past the size limit: error = %v, want edit size limit ValidationError", err)
+	}
+
+	_, err = client.EditPage(context.Background(), EditPageArgs{Title: "Page", PrependText: strings.Repeat("b", 21)})
+	if !errors.As(err, &vErr) || vErr.Field != "prepend_text" || !strings.Contains(vErr.Message, "edit delta limit of 20 bytes") {
+		t.Fatalf("prepend past the delta limit: error = %v, want edit delta limit ValidationError", err)
+	}
+
+	if _, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", AppendText: strings.Repeat("b", 10)}); err != nil {
+		t.Fatalf("append up to the size limit: %v", err)
+	}
+	if n := edits.Load(); n != 1 {
+		t.Errorf("edit calls = %d, want 1", n)
+	}
+}
+
+func TestEditPage_MaxEditDeltaBytes(t *testing.T) {
+	var edits atomic.Int32
+	client := createGuardMockServer(t, strings.Repeat("a", 100), &edits)
+	client.config.MaxEditDeltaBytes = 50
+
+	tests := []struct {
+		name    string
+		size    int
+		wantErr bool
+	}{
+		{"small growth", 140, false},
+		{"large growth", 151, true},
+		{"large shrink", 40, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", Content: strings.Repeat("b", tt.size)})
+			var vErr *ValidationError
+			if tt.wantErr != errors.As(err, &vErr) {
+				t.Fatalf("EditPage(%d bytes) error = %v, wantErr %v", tt.size, err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(vErr.Message, "edit delta limit of 50 bytes") {
+				t.Errorf("message = %q, want delta limit explanation", vErr.Message)
+			}
+		})
+	}
+
+	if _, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", Section: "1", Content: "tiny"}); err != nil {
+		t.Errorf("section edit should skip the delta check: %v", err)
+	}
+	if n := edits.Load(); n != 2 {
+		t.Errorf("edit calls = %d, want 2", n)
+	}
+}
+
+func TestBulkReplace_MaxEditDeltaBytes(t *testing.T) {
+	var edits atomic.Int32
+	client := createGuardMockServer(t, "x x x x x", &edits)
+	client.config.MaxEditDeltaBytes = 20
+
+	result, err := client.BulkReplace(context.Background(), BulkReplaceArgs{
+		Pages:   []string{"Page"},
+		Find:    "x",
+		Replace: "xxxxxxxx",
+		Preview: boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("BulkReplace: %v", err)
+	}
+	if len(result.Results) != 1 || !strings.Contains(result.Results[0].Error, "edit delta limit") {
+		t.Fatalf("Results = %+v, want per-page delta limit error", result.Results)
+	}
+	if result.PagesModified != 0 || edits.Load() != 0 {
+		t.Errorf("PagesModified = %d, edit calls = %d, want 0 and 0", result.PagesModified, edits.Load())
+	}
+}
+
+func TestEditPage_BlankingGuard(t *testing.T) {
+	var edits atomic.Int32
+	original := strings.Repeat("Important content. ", 20)
+	client := createGuardMockServer(t, original, &edits)
+
+	tests := []struct {
+		name    string
+		args    EditPageArgs
+		wantErr bool
+	}{
+		{"whitespace only", EditPageArgs{Title: "Page", Content: " "}, true},
+		{"large deletion", EditPageArgs{Title: "Page", Content: "Stub."}, true},
+		{"whitespace change", EditPageArgs{Title: "Page", Content: strings.TrimSpace(original) + "\n"}, false},
+		{"large deletion allowed", EditPageArgs{Title: "Page", Content: "Stub.", AllowBlanking: true}, false},
+		{"blanking allowed", EditPageArgs{Title: "Page", Content: " ", AllowBlanking: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.EditPage(context.Background(), tt.args)
+			var vErr *ValidationError
+			if tt.wantErr != errors.As(err, &vErr) {
+				t.Fatalf("EditPage error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(vErr.Message, "blanking guard") {
+				t.Errorf("message = %q, want blanking guard explanation", vErr.Message)
+			}
+		})
+	}
+	if n := edits.Load(); n != 3 {
+		t.Errorf("edit calls = %d, want 3", n)
+	}
+}
+
+func TestEditPage_BlankingThresholdPercent(t *testing.T) {
+	var edits atomic.Int32
+	client := createGuardMockServer(t, strings.Repeat("a", 100), &edits)
+	client.config.BlankingThresholdPercent = 50
+
+	if _, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", Content: strings.Repeat("a", 40)}); err == nil {
+		t.Error("expected a 60% shrink to be refused with a 50% threshold")
+	}
+	if _, err := client.EditPage(context.Background(), EditPageArgs{Title: "Page", Content: strings.Repeat("a", 60)}); err != nil {
+		t.Errorf("40%% shrink should pass a 50%% threshold: %v", err)
+	}
+}
+
+func TestEditableNamespaces(t *testing.T) {
+	var edits, moves atomic.Int32
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "query":
+			if r.FormValue("prop") == "info" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"query": map[string]interface{}{"pages": map[string]interface{}{
+						"1": map[string]interface{}{"pageid": float64(1), "title": r.FormValue("titles")},
+					}},
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"query": map[string]interface{}{
+					"namespaces": map[string]interface{}{
+						"0":   map[string]interface{}{"id": float64(0), "*": ""},
+						"2":   map[string]interface{}{"id": float64(2), "*": "User", "canonical": "User"},
+						"6":   map[string]interface{}{"id": float64(6), "*": "File", "canonical": "File"},
+						"8":   map[string]interface{}{"id": float64(8), "*": "MediaWiki", "canonical": "MediaWiki"},
+						"828": map[string]interface{}{"id": float64(828), "*": "Module", "canonical": "Module"},
+					},
+					"namespacealiases": []interface{}{},
+				},
+			})
+		case "edit":
+			edits.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"edit": map[string]interface{}{"result": "Success", "pageid": float64(1), "title": r.FormValue("title"), "newrevid": float64(2)},
+			})
+		case "move":
+			moves.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"move": map[string]interface{}{"from": r.FormValue("from"), "to": r.FormValue("to")},
+			})
+		}
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+	client.config.EditableNamespaces = []int{0, 2}
+
+	for _, title := range []string{"Main Page", "User:Alice/Sandbox", "Star Wars: Andor"} {
+		if _, err := client.EditPage(context.Background(), EditPageArgs{Title: title, Content: "text", AllowBlanking: true}); err != nil {
+			t.Errorf("EditPage(%q): %v", title, err)
+		}
+	}
+	for _, title := range []string{"MediaWiki:Common.js", "module:Citation"} {
+		_, err := client.EditPage(context.Background(), EditPageArgs{Title: title, Content: "text", AllowBlanking: true})
+		var vErr *ValidationError
+		if !errors.As(err, &vErr) || !strings.Contains(vErr.Message, "not allowed to edit") {
+			t.Errorf("EditPage(%q) error = %v, want a namespace ValidationError", title, err)
+		}
+	}
+	if n := edits.Load(); n != 3 {
+		t.Errorf("edit calls = %d, want 3", n)
+	}
+
+	if _, err := client.MovePage(context.Background(), MovePageArgs{From: "Draft", To: "User:Alice/Draft"}); err != nil {
+		t.Errorf("MovePage within allowed namespaces: %v", err)
+	}
+	if _, err := client.MovePage(context.Background(), MovePageArgs{From: "Draft", To: "Module:Draft"}); err == nil {
+		t.Error("expected MovePage into Module: to be refused")
+	}
+	if n := moves.Load(); n != 1 {
+		t.Errorf("move calls = %d, want 1", n)
+	}
+
+	nullEdits, err := client.NullEditPages(context.Background(), NullEditPagesArgs{Pages: []string{"MediaWiki:Common.js"}})
+	if err != nil {
+		t.Fatalf("NullEditPages: %v", err)
+	}
+	if nullEdits.FailureCount != 1 || !strings.Contains(nullEdits.Results[0].Error, "not allowed to edit") {
+		t.Errorf("NullEditPages result = %+v, want the MediaWiki: page refused", nullEdits)
+	}
+	if n := edits.Load(); n != 3 {
+		t.Errorf("edit calls after null edit = %d, want 3", n)
+	}
+
+	_, err = client.UploadFile(context.Background(), UploadFileArgs{Filename: "Logo.png", FileData: []byte("png")})
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || !strings.Contains(vErr.Message, "namespace 6") {
+		t.Errorf("UploadFile error = %v, want the File namespace refused", err)
+	}
+}
@@ -39,20 +39,8 @@ func (c *Client) performMove(ctx context.Context, args MovePageArgs) (map[string
 		params.Set("movesubpages", "1")
 	}
 
-	resp, err := c.apiRequest(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-
-	// Check for badtoken error so caller can retry
-	if errInfo, ok := resp["error"].(map[string]interface{}); ok {
-		code := getString(errInfo["code"])
-		if code == "badtoken" {
-			return nil, fmt.Errorf("%s: %s", code, getString(errInfo["info"]))
-		}
-	}
-
-	return resp, nil
+	// A badtoken failure surfaces as *APIError so the caller can retry
+	return c.apiRequest(ctx, params)
 }
 
 // MovePage moves (renames) a wiki page
@@ -73,24 +61,25 @@ func (c *Client) MovePage(ctx context.Context, args MovePageArgs) (MovePageResul
 	if err := c.EnsureLoggedIn(ctx); err != nil {
 		return MovePageResult{}, fmt.Errorf("authentication required for page moves: %w", err)
 	}
-
-	resp, err := c.performMove(ctx, args)
-	if err != nil && strings.Contains(err.Error(), "badtoken") {
-		c.invalidateCSRFToken()
-		resp, err = c.performMove(ctx, args)
+	// A move changes both titles, so both must be editable
+	for _, title := range []string{args.From, args.To} {
+		if err := c.checkEditableNamespace(ctx, title); err != nil {
+			return MovePageResult{}, err
+		}
 	}
-	if err != nil {
-		return MovePageResult{}, err
+	if c.config.DryRun {
+		return c.dryRunMove(args), nil
 	}
 
-	// Check for errors
-	if errInfo, ok := resp["error"].(map[string]interface{}); ok {
-		return MovePageResult{
-			Success: false,
-			From:    args.From,
-			To:      args.To,
-			Message: fmt.Sprintf("Move failed: %s", getString(errInfo["info"])),
-		}, nil
+	resp, err := retryOnBadToken(c, func() (map[string]interface{}, error) {
+		return c.performMove(ctx, args)
+	})
+	if err != nil {
+		if IsAPIErrorCode(err, "articleexists") {
+			return MovePageResult{From: args.From, To: args.To},
+				WrapAPIError("articleexists", fmt.Sprintf("Cannot move '%s' to '%s': a page with the target title already exists", args.From, args.To), "move")
+		}
+		return MovePageResult{}, err
 	}
 
 	moveData, ok := resp["move"].(map[string]interface{})
@@ -103,12 +92,15 @@ func (c *Client) MovePage(ctx context.Context, args MovePageArgs) (MovePageResul
 		}, nil
 	}
 
+	_, redirectCreated := moveData["redirectcreated"]
 	result := MovePageResult{
-		Success: true,
-		From:    getString(moveData["from"]),
-		To:      getString(moveData["to"]),
-		Reason:  args.Reason,
-		Message: fmt.Sprintf("Page moved from '%s' to '%s'", getString(moveData["from"]), getString(moveData["to"])),
+		Success:         true,
+		From:            getString(moveData["from"]),
+		To:              getString(moveData["to"]),
+		Reason:          args.Reason,
+		RedirectCreated: redirectCreated,
+		Warnings:        apiWarnings(resp),
+		Message:         fmt.Sprintf("Page moved from '%s' to '%s'", getString(moveData["from"]), getString(moveData["to"])),
 	}
 
 	// Check if talk page was moved
@@ -124,7 +116,7 @@ func (c *Client) MovePage(ctx context.Context, args MovePageArgs) (MovePageResul
 	// Log the move
 	c.logAudit(AuditEntry{
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
-		Operation: "move",
+		Operation: AuditOpMove,
 		Title:     result.From + " → " + result.To,
 		Summary:   args.Reason,
 		WikiURL:   c.config.BaseURL,
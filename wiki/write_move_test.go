@@ -3,6 +3,7 @@ package wiki
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 	"testing"
@@ -13,11 +14,15 @@ func TestMovePage_Success(t *testing.T) {
 		if r.FormValue("action") == "move" {
 			response := map[string]interface{}{
 				"move": map[string]interface{}{
-					"from":     "Old Title",
-					"to":       "New Title",
-					"reason":   "Renaming",
-					"talkfrom": "Talk:Old Title",
-					"talkto":   "Talk:New Title",
+					"from":            "Old Title",
+					"to":              "New Title",
+					"reason":          "Renaming",
+					"redirectcreated": "",
+					"talkfrom":        "Talk:Old Title",
+					"talkto":          "Talk:New Title",
+				},
+				"warnings": map[string]interface{}{
+					"move": map[string]interface{}{"*": "The talk page could not be moved."},
 				},
 			}
 			w.Header().Set("Content-Type", "application/json")
@@ -48,6 +53,12 @@ func TestMovePage_Success(t *testing.T) {
 	if !result.TalkMoved {
 		t.Error("expected TalkMoved=true when talkfrom is present")
 	}
+	if !result.RedirectCreated {
+		t.Error("expected RedirectCreated=true when redirectcreated is present")
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0] != "move: The talk page could not be moved." {
+		t.Errorf("Warnings = %q, want the API's move warning", result.Warnings)
+	}
 	if !strings.Contains(result.RedirectURL, "Old_Title") {
 		t.Errorf("RedirectURL should reference the source title, got: %q", result.RedirectURL)
 	}
@@ -84,8 +95,12 @@ func TestMovePage_APIError(t *testing.T) {
 	if result.Success {
 		t.Error("expected success=false on API error")
 	}
-	if !strings.Contains(err.Error(), "already exists") {
-		t.Errorf("error = %v, want it to surface the API info", err)
+	var wikiErr *WikiError
+	if !errors.As(err, &wikiErr) || wikiErr.Code != "articleexists" {
+		t.Fatalf("error = %T %v, want a *WikiError with code articleexists", err, err)
+	}
+	if !strings.Contains(err.Error(), "already exists") || !strings.Contains(err.Error(), "Existing Title") {
+		t.Errorf("error = %v, want it to name the existing target", err)
 	}
 }
 
@@ -0,0 +1,209 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProtectPage sets or lifts protection on a page for each requested action.
+// The page's protection is read before the change so the result shows both
+// states; the after state is read back from the wiki rather than assumed.
+func (c *Client) ProtectPage(ctx context.Context, args ProtectPageArgs) (ProtectPageResult, error) {
+	if args.Title == "" {
+		return ProtectPageResult{}, &ValidationError{
+			Field:   "title",
+			Message: "page title is required",
+		}
+	}
+	if len(args.Protections) == 0 {
+		return ProtectPageResult{}, &ValidationError{
+			Field:      "protections",
+			Message:    "at least one action→level protection is required",
+			Suggestion: `Pass e.g. {"edit": "sysop", "move": "sysop"}; use "all" as the level to lift protection.`,
+		}
+	}
+	requested := requestedProtections(args)
+	for _, p := range requested {
+		if p.Action == "" || p.Level == "" {
+			return ProtectPageResult{}, &ValidationError{
+				Field:   "protections",
+				Value:   p.Action + "=" + p.Level,
+				Message: "protection actions and levels must not be empty",
+			}
+		}
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return ProtectPageResult{}, fmt.Errorf("authentication required for page protection: %w", err)
+	}
+	if err := c.checkEditableNamespace(ctx, args.Title); err != nil {
+		return ProtectPageResult{}, err
+	}
+
+	before, err := c.currentProtection(ctx, args.Title)
+	if err != nil {
+		return ProtectPageResult{}, err
+	}
+	if c.config.DryRun {
+		return c.dryRunProtect(args, before, requested), nil
+	}
+
+	resp, err := retryOnBadToken(c, func() (map[string]interface{}, error) {
+		token, err := c.getCSRFToken(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+		return c.apiRequest(ctx, buildProtectParams(args, requested, token))
+	})
+	if err != nil {
+		if IsAPIErrorCode(err, "permissiondenied") {
+			return ProtectPageResult{}, NewPermissionDeniedError("protecting pages", "protect")
+		}
+		return ProtectPageResult{}, err
+	}
+
+	protectData, ok := resp["protect"].(map[string]interface{})
+	if !ok {
+		return ProtectPageResult{
+			Success: false,
+			Title:   args.Title,
+			Before:  before,
+			Message: "Unexpected response format",
+		}, nil
+	}
+	c.InvalidateCachePrefix("page_info:" + normalizePageTitle(args.Title))
+
+	result := ProtectPageResult{
+		Success: true,
+		Title:   getString(protectData["title"]),
+		Reason:  args.Reason,
+		Before:  before,
+		Applied: parseAppliedProtections(protectData["protections"]),
+	}
+	_, result.Cascade = protectData["cascade"]
+	if result.Title == "" {
+		result.Title = args.Title
+	}
+	if after, err := c.currentProtection(ctx, result.Title); err == nil {
+		result.After = after
+	} else {
+		c.logger.Debug("Protection read-back failed", "title", result.Title, "error", err)
+	}
+	result.Message = fmt.Sprintf("Protection of '%s' set to %s", result.Title, describeProtections(result.Applied))
+
+	c.logAudit(AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Operation: AuditOpProtect,
+		Title:     result.Title,
+		Summary:   args.Reason,
+		WikiURL:   c.config.BaseURL,
+		Success:   true,
+	})
+
+	return result, nil
+}
+
+// requestedProtections turns the action→level map into a list sorted by
+// action, so the API request and dry-run output are deterministic.
+func requestedProtections(args ProtectPageArgs) []AppliedProtection {
+	expiry := args.Expiry
+	if expiry == "" {
+		expiry = "infinite"
+	}
+	out := make([]AppliedProtection, 0, len(args.Protections))
+	for action, level := range args.Protections {
+		out = append(out, AppliedProtection{
+			Action: strings.TrimSpace(action),
+			Level:  strings.TrimSpace(level),
+			Expiry: expiry,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Action < out[j].Action })
+	return out
+}
+
+// buildProtectParams assembles the action=protect request.
+func buildProtectParams(args ProtectPageArgs, requested []AppliedProtection, token string) url.Values {
+	protections := make([]string, 0, len(requested))
+	for _, p := range requested {
+		protections = append(protections, p.Action+"="+p.Level)
+	}
+
+	params := url.Values{}
+	params.Set("action", "protect")
+	params.Set("title", args.Title)
+	params.Set("protections", strings.Join(protections, "|"))
+	params.Set("expiry", requested[0].Expiry) // one expiry applies to every protection
+	params.Set("token", token)
+	if args.Reason != "" {
+		params.Set("reason", args.Reason)
+	}
+	if args.Cascade {
+		params.Set("cascade", "1")
+	}
+	return params
+}
+
+// currentProtection returns a page's protection entries, formatted as in
+// PageInfo.Protection. It bypasses the page info cache, since the point is
+// to see the state right before or after a change.
+func (c *Client) currentProtection(ctx context.Context, title string) ([]string, error) {
+	params := url.Values{}
+	params.Set("action", "query")
+	params.Set("titles", title)
+	params.Set("prop", "info")
+	params.Set("inprop", "protection")
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := resp["query"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected API response: missing 'query' object")
+	}
+	for _, pageData := range getMap(query["pages"]) {
+		entries := extractProtectionEntries(getMap(pageData)["protection"])
+		if entries == nil {
+			entries = []string{}
+		}
+		return entries, nil
+	}
+	return []string{}, nil
+}
+
+// parseAppliedProtections reads the protect response's list, where each
+// entry holds one action→level pair next to its expiry.
+func parseAppliedProtections(raw interface{}) []AppliedProtection {
+	var out []AppliedProtection
+	for _, p := range getSlice(raw) {
+		entry := getMap(p)
+		for action, level := range entry {
+			if action == "expiry" {
+				continue
+			}
+			out = append(out, AppliedProtection{
+				Action: action,
+				Level:  getString(level),
+				Expiry: getString(entry["expiry"]),
+			})
+		}
+	}
+	return out
+}
+
+// describeProtections renders protections as "edit=sysop, move=sysop".
+func describeProtections(protections []AppliedProtection) string {
+	parts := make([]string, 0, len(protections))
+	for _, p := range protections {
+		parts = append(parts, p.Action+"="+p.Level)
+	}
+	if len(parts) == 0 {
+		return "no change"
+	}
+	return strings.Join(parts, ", ")
+}
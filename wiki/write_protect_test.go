@@ -0,0 +1,111 @@
+package wiki
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestProtectPage_Success(t *testing.T) {
+	protected := false
+	var sawProtections, sawExpiry, sawCascade string
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "query":
+			if protected {
+				_, _ = w.Write([]byte(`{"query":{"pages":{"5":{"pageid":5,"title":"Main Page","protection":[` +
+					`{"type":"edit","level":"sysop","expiry":"infinity"},{"type":"move","level":"autoconfirmed","expiry":"infinity"}]}}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"query":{"pages":{"5":{"pageid":5,"title":"Main Page","protection":[` +
+				`{"type":"move","level":"sysop","expiry":"infinity"}]}}}}`))
+		case "protect":
+			protected = true
+			sawProtections = r.FormValue("protections")
+			sawExpiry = r.FormValue("expiry")
+			sawCascade = r.FormValue("cascade")
+			_, _ = w.Write([]byte(`{"protect":{"title":"Main Page","reason":"Vandalism","cascade":"",` +
+				`"protections":[{"edit":"sysop","expiry":"infinite"},{"move":"autoconfirmed","expiry":"infinite"}]}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+	var audit bytes.Buffer
+	client.SetAuditLogger(NewWriterAuditLogger(&audit, client.logger))
+
+	result, err := client.ProtectPage(context.Background(), ProtectPageArgs{
+		Title:       "Main Page",
+		Protections: map[string]string{"move": "autoconfirmed", "edit": "sysop"},
+		Reason:      "Vandalism",
+		Cascade:     true,
+	})
+	if err != nil {
+		t.Fatalf("ProtectPage failed: %v", err)
+	}
+	if sawProtections != "edit=sysop|move=autoconfirmed" || sawExpiry != "infinite" || sawCascade != "1" {
+		t.Errorf("request protections=%q expiry=%q cascade=%q", sawProtections, sawExpiry, sawCascade)
+	}
+	if !result.Success || !result.Cascade {
+		t.Errorf("result = %+v, want cascading success", result)
+	}
+	if want := []string{"move: sysop"}; !reflect.DeepEqual(result.Before, want) {
+		t.Errorf("Before = %q, want %q", result.Before, want)
+	}
+	if want := []string{"edit: sysop", "move: autoconfirmed"}; !reflect.DeepEqual(result.After, want) {
+		t.Errorf("After = %q, want %q", result.After, want)
+	}
+	if len(result.Applied) != 2 || result.Applied[0] != (AppliedProtection{Action: "edit", Level: "sysop", Expiry: "infinite"}) {
+		t.Errorf("Applied = %+v, want edit and move entries", result.Applied)
+	}
+	if !strings.Contains(audit.String(), `"operation":"protect"`) {
+		t.Errorf("audit log = %q, want a protect entry", audit.String())
+	}
+}
+
+func TestProtectPage_Validation(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	for name, args := range map[string]ProtectPageArgs{
+		"empty title":       {Protections: map[string]string{"edit": "sysop"}},
+		"no protections":    {Title: "Main Page"},
+		"empty level":       {Title: "Main Page", Protections: map[string]string{"edit": " "}},
+		"empty action name": {Title: "Main Page", Protections: map[string]string{"": "sysop"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.ProtectPage(context.Background(), args)
+			if _, ok := err.(*ValidationError); !ok {
+				t.Errorf("error = %T %v, want *ValidationError", err, err)
+			}
+		})
+	}
+}
+
+func TestProtectPage_PermissionDenied(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("action") == "protect" {
+			_, _ = w.Write([]byte(`{"error":{"code":"permissiondenied","info":"You don't have permission to change protection levels."}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"query":{"pages":{"5":{"pageid":5,"title":"Main Page","protection":[]}}}}`))
+	})
+	defer server.Close()
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.ProtectPage(context.Background(), ProtectPageArgs{
+		Title:       "Main Page",
+		Protections: map[string]string{"edit": "sysop"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "'protect' right") {
+		t.Errorf("error = %v, want a permission error naming the protect right", err)
+	}
+}
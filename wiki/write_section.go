@@ -0,0 +1,96 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EditSection replaces the content of a single section of an existing page
+// via action=edit&section=N. Only the targeted section is sent, so concurrent
+// edits elsewhere on the page are preserved. When BaseRevID is set, a
+// conflicting change to the same section fails with an 'editconflict' error
+// instead of being overwritten.
+func (c *Client) EditSection(ctx context.Context, args EditSectionArgs) (EditResult, error) {
+	if err := validateEditSectionArgs(args); err != nil {
+		return EditResult{}, err
+	}
+
+	editArgs := EditPageArgs{
+		Title:   args.Title,
+		Content: args.Content,
+		Summary: args.Summary,
+		Minor:   args.Minor,
+		Section: strconv.Itoa(*args.Section),
+	}
+	// Section edits skip EditPage, so the size limit is applied here; the
+	// delta and blanking checks need the whole page and do not apply.
+	if err := c.checkEditGuardrails(ctx, editArgs); err != nil {
+		return EditResult{}, err
+	}
+	extra := buildEditSectionExtraParams(args)
+
+	editResult, err := retryOnBadToken(c, func() (EditResult, error) {
+		return c.performEdit(ctx, editArgs, extra)
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "editconflict") {
+			return EditResult{}, WrapAPIError("editconflict", err.Error(), "edit_section")
+		}
+		return EditResult{}, err
+	}
+	if editResult.Success {
+		editResult.Message = fmt.Sprintf("Section %d edited successfully", *args.Section)
+	}
+	return editResult, nil
+}
+
+// validateEditSectionArgs checks required fields and content safety for a
+// section edit.
+func validateEditSectionArgs(args EditSectionArgs) error {
+	if args.Title == "" {
+		return &ValidationError{
+			Field:   "title",
+			Message: "page title is required",
+		}
+	}
+	if args.Section == nil {
+		return &ValidationError{
+			Field:      "section",
+			Message:    "section index is required",
+			Suggestion: "Use mediawiki_get_sections to list the page's sections and their indices. Use 0 for the intro.",
+		}
+	}
+	if *args.Section < 0 {
+		return &ValidationError{
+			Field:   "section",
+			Value:   strconv.Itoa(*args.Section),
+			Message: "section index must be 0 or greater",
+		}
+	}
+	if args.Content == "" {
+		return &ValidationError{
+			Field:      "content",
+			Message:    "section content is required",
+			Suggestion: "Provide the full replacement wikitext for the section, including its heading line.",
+		}
+	}
+	if err := ValidateContentSize(args.Content, args.Title, MaxEditSize); err != nil {
+		return err
+	}
+	return ValidateWikitextContent(args.Content, args.Title)
+}
+
+// buildEditSectionExtraParams returns the edit parameters specific to
+// section edits. nocreate stops a section edit on a missing page from
+// silently creating it.
+func buildEditSectionExtraParams(args EditSectionArgs) url.Values {
+	extra := url.Values{}
+	extra.Set("nocreate", "1")
+	if args.BaseRevID > 0 {
+		extra.Set("baserevid", strconv.Itoa(args.BaseRevID))
+	}
+	return extra
+}
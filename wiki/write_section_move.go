@@ -0,0 +1,155 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MoveSection moves one section from FromTitle to ToTitle in two edits:
+// the section wikitext is first added to the target page, then removed from
+// the source. Doing the target first means a failure can only leave the
+// section duplicated, never lost. The target edit appends or prepends, so
+// MediaWiki merges it into the current revision; the source edit is based
+// on the revision the section was read from, so a concurrent change to the
+// source fails with an edit conflict instead of removing the wrong section.
+// Both edits are audit-logged.
+func (c *Client) MoveSection(ctx context.Context, args MoveSectionArgs) (MoveSectionResult, error) {
+	position, err := validateMoveSectionArgs(args)
+	if err != nil {
+		return MoveSectionResult{}, err
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return MoveSectionResult{}, fmt.Errorf("authentication required for section moves: %w", err)
+	}
+
+	fromTitle := normalizePageTitle(args.FromTitle)
+	section, err := c.getSectionContent(ctx, fromTitle, *args.FromSection, "wikitext")
+	if err != nil {
+		return MoveSectionResult{}, fmt.Errorf("failed to read section %d of '%s': %w", *args.FromSection, args.FromTitle, err)
+	}
+	sectionText := strings.TrimSpace(section.SectionContent)
+	if sectionText == "" {
+		return MoveSectionResult{}, fmt.Errorf("section %d of '%s' is empty or does not exist", *args.FromSection, args.FromTitle)
+	}
+
+	toTitle := normalizePageTitle(args.ToTitle)
+	_, exists, err := c.currentPageLength(ctx, toTitle)
+	if err != nil {
+		return MoveSectionResult{}, fmt.Errorf("failed to read target page '%s': %w", args.ToTitle, err)
+	}
+	if !exists {
+		return MoveSectionResult{}, NewPageNotFoundError(args.ToTitle)
+	}
+
+	result := MoveSectionResult{
+		FromTitle:    section.Title,
+		ToTitle:      toTitle,
+		SectionTitle: section.SectionTitle,
+		Position:     position,
+	}
+	summary := args.Summary
+	if summary == "" {
+		summary = fmt.Sprintf("Moved section \"%s\" from [[%s]] to [[%s]]", section.SectionTitle, result.FromTitle, result.ToTitle)
+	}
+
+	targetEdit, err := c.EditPage(ctx, sectionAddition(toTitle, sectionText, position, summary))
+	if err != nil {
+		return MoveSectionResult{}, fmt.Errorf("failed to add section to '%s': %w", toTitle, err)
+	}
+	if !targetEdit.Success {
+		return MoveSectionResult{}, fmt.Errorf("failed to add section to '%s': %s", toTitle, targetEdit.Message)
+	}
+	if targetEdit.Title != "" {
+		result.ToTitle = targetEdit.Title
+	}
+	result.TargetRevisionID = targetEdit.RevisionID
+
+	sourceEdit, err := c.removeSection(ctx, result.FromTitle, *args.FromSection, section.Revision, summary)
+	if err == nil && !sourceEdit.Success {
+		err = fmt.Errorf("%s", sourceEdit.Message)
+	}
+	if err != nil {
+		result.Partial = true
+		result.SourceError = err.Error()
+		result.Message = fmt.Sprintf("Section was added to '%s' (revision %d) but could not be removed from '%s'. The section now exists on both pages; remove it from the source manually or undo the target edit.",
+			result.ToTitle, result.TargetRevisionID, result.FromTitle)
+		return result, nil
+	}
+
+	result.Success = true
+	result.SourceRevisionID = sourceEdit.RevisionID
+	result.Message = fmt.Sprintf("Moved section \"%s\" from '%s' to '%s'", result.SectionTitle, result.FromTitle, result.ToTitle)
+	return result, nil
+}
+
+// validateMoveSectionArgs checks required fields and returns the resolved
+// position ("append" or "prepend").
+func validateMoveSectionArgs(args MoveSectionArgs) (string, error) {
+	if args.FromTitle == "" {
+		return "", &ValidationError{Field: "from_title", Message: "source page title is required"}
+	}
+	if args.ToTitle == "" {
+		return "", &ValidationError{Field: "to_title", Message: "target page title is required"}
+	}
+	if args.FromSection == nil || *args.FromSection < 1 {
+		value := ""
+		if args.FromSection != nil {
+			value = strconv.Itoa(*args.FromSection)
+		}
+		return "", &ValidationError{
+			Field:      "from_section",
+			Value:      value,
+			Message:    "a section index of 1 or greater is required",
+			Suggestion: "Use mediawiki_get_sections on the source page to find the section index. The intro (section 0) cannot be moved.",
+		}
+	}
+	if normalizePageTitle(args.FromTitle) == normalizePageTitle(args.ToTitle) {
+		return "", &ValidationError{Field: "to_title", Value: args.ToTitle, Message: "target page must differ from the source page"}
+	}
+
+	position := strings.ToLower(args.Position)
+	switch position {
+	case "":
+		position = "append"
+	case "append", "prepend":
+	default:
+		return "", &ValidationError{
+			Field:   "position",
+			Value:   args.Position,
+			Message: "position must be 'append' or 'prepend'",
+		}
+	}
+	return position, nil
+}
+
+// sectionAddition builds the target edit that adds sectionText to the start
+// or end of the page, separated from the existing content by a blank line.
+func sectionAddition(title, sectionText, position, summary string) EditPageArgs {
+	args := EditPageArgs{Title: title, Summary: summary}
+	if position == "prepend" {
+		args.PrependText = sectionText + "\n\n"
+	} else {
+		args.AppendText = "\n\n" + sectionText
+	}
+	return args
+}
+
+// removeSection deletes a section by saving it with empty text, which
+// MediaWiki treats as removing the section and its heading. baseRevID is
+// the revision the section was read from, so the wiki refuses the removal
+// if the page changed underneath it.
+func (c *Client) removeSection(ctx context.Context, title string, section, baseRevID int, summary string) (EditResult, error) {
+	args := EditPageArgs{
+		Title:   title,
+		Summary: summary,
+		Section: strconv.Itoa(section),
+	}
+	extra := buildEditSectionExtraParams(EditSectionArgs{BaseRevID: baseRevID})
+
+	return retryOnBadToken(c, func() (EditResult, error) {
+		return c.performEdit(ctx, args, extra)
+	})
+}
@@ -0,0 +1,183 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// moveSectionMockServer serves a source page with one movable section and a
+// target page, and records each edit in order. When failSource is set, the
+// edit that removes the section from the source is rejected.
+func moveSectionMockServer(t *testing.T, edits *[]map[string]string, failSource bool) *httptest.Server {
+	t.Helper()
+	return mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.FormValue("action") {
+		case "parse":
+			response := map[string]interface{}{
+				"parse": map[string]interface{}{
+					"title":    "Source",
+					"pageid":   float64(1),
+					"revid":    float64(55),
+					"wikitext": map[string]interface{}{"*": "== Setup ==\nInstall the tool.\n"},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		case "query":
+			response := map[string]interface{}{
+				"query": map[string]interface{}{
+					"pages": map[string]interface{}{
+						"2": map[string]interface{}{
+							"pageid":    float64(2),
+							"title":     "Target",
+							"lastrevid": float64(20),
+							"revisions": []interface{}{
+								map[string]interface{}{
+									"slots": map[string]interface{}{
+										"main": map[string]interface{}{"content": "Target intro."},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		case "edit":
+			_, sentText := r.PostForm["text"]
+			*edits = append(*edits, map[string]string{
+				"title":       r.FormValue("title"),
+				"text":        r.FormValue("text"),
+				"sent_text":   strconv.FormatBool(sentText),
+				"appendtext":  r.FormValue("appendtext"),
+				"prependtext": r.FormValue("prependtext"),
+				"section":     r.FormValue("section"),
+				"baserevid":   r.FormValue("baserevid"),
+			})
+			if failSource && r.FormValue("title") == "Source" {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"error": map[string]interface{}{"code": "protectedpage", "info": "This page has been protected."},
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"edit": map[string]interface{}{
+					"result":   "Success",
+					"title":    r.FormValue("title"),
+					"pageid":   float64(len(*edits)),
+					"newrevid": float64(100 + len(*edits)),
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func TestMoveSection_TwoEditFlow(t *testing.T) {
+	var edits []map[string]string
+	server := moveSectionMockServer(t, &edits, false)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.MoveSection(context.Background(), MoveSectionArgs{
+		FromTitle:   "Source",
+		FromSection: intPtr(2),
+		ToTitle:     "Target",
+	})
+	if err != nil {
+		t.Fatalf("MoveSection failed: %v", err)
+	}
+	if !result.Success || result.Partial {
+		t.Fatalf("result = %+v, want full success", result)
+	}
+	if len(edits) != 2 {
+		t.Fatalf("got %d edits, want 2", len(edits))
+	}
+
+	target, source := edits[0], edits[1]
+	if target["title"] != "Target" || target["section"] != "" || target["sent_text"] != "false" {
+		t.Errorf("first edit = %+v, want an append to Target without full text", target)
+	}
+	if want := "\n\n== Setup ==\nInstall the tool."; target["appendtext"] != want {
+		t.Errorf("target appendtext = %q, want %q", target["appendtext"], want)
+	}
+	if source["title"] != "Source" || source["section"] != "2" || source["text"] != "" {
+		t.Errorf("second edit = %+v, want empty text for section 2 of Source", source)
+	}
+	if source["baserevid"] != "55" {
+		t.Errorf("source baserevid = %q, want the revision the section was read from (55)", source["baserevid"])
+	}
+	if result.SectionTitle != "Setup" || result.TargetRevisionID != 101 || result.SourceRevisionID != 102 {
+		t.Errorf("result = %+v", result)
+	}
+}
+
+func TestMoveSection_SourceEditFailsReportsPartial(t *testing.T) {
+	var edits []map[string]string
+	server := moveSectionMockServer(t, &edits, true)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.MoveSection(context.Background(), MoveSectionArgs{
+		FromTitle:   "Source",
+		FromSection: intPtr(2),
+		ToTitle:     "Target",
+		Position:    "prepend",
+	})
+	if err != nil {
+		t.Fatalf("MoveSection failed: %v", err)
+	}
+	if result.Success || !result.Partial {
+		t.Fatalf("result = %+v, want partial failure", result)
+	}
+	if !strings.Contains(result.SourceError, "protectedpage") {
+		t.Errorf("SourceError = %q, want the source edit error", result.SourceError)
+	}
+	if result.TargetRevisionID != 101 {
+		t.Errorf("TargetRevisionID = %d, want 101", result.TargetRevisionID)
+	}
+	if want := "== Setup ==\nInstall the tool.\n\n"; edits[0]["prependtext"] != want || edits[0]["appendtext"] != "" {
+		t.Errorf("prepend edit = %+v, want prependtext %q", edits[0], want)
+	}
+}
+
+func TestMoveSection_Validation(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	tests := []struct {
+		name  string
+		args  MoveSectionArgs
+		field string
+	}{
+		{"missing source", MoveSectionArgs{FromSection: intPtr(1), ToTitle: "B"}, "from_title"},
+		{"missing target", MoveSectionArgs{FromTitle: "A", FromSection: intPtr(1)}, "to_title"},
+		{"missing section", MoveSectionArgs{FromTitle: "A", ToTitle: "B"}, "from_section"},
+		{"intro section", MoveSectionArgs{FromTitle: "A", FromSection: intPtr(0), ToTitle: "B"}, "from_section"},
+		{"same page", MoveSectionArgs{FromTitle: "A", FromSection: intPtr(1), ToTitle: "A"}, "to_title"},
+		{"bad position", MoveSectionArgs{FromTitle: "A", FromSection: intPtr(1), ToTitle: "B", Position: "middle"}, "position"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.MoveSection(context.Background(), tt.args)
+			valErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+			}
+			if valErr.Field != tt.field {
+				t.Errorf("Field = %q, want %q", valErr.Field, tt.field)
+			}
+		})
+	}
+}
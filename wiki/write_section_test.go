@@ -0,0 +1,132 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestEditSection_SendsOnlySection(t *testing.T) {
+	var got url.Values
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("action") == "edit" {
+			got = r.PostForm
+			response := map[string]interface{}{
+				"edit": map[string]interface{}{
+					"result":   "Success",
+					"pageid":   float64(10),
+					"title":    "Guide",
+					"newrevid": float64(77),
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	sectionText := "== Install ==\nRun the installer."
+	result, err := client.EditSection(context.Background(), EditSectionArgs{
+		Title:     "Guide",
+		Section:   intPtr(2),
+		Content:   sectionText,
+		Summary:   "Update install steps",
+		BaseRevID: 76,
+	})
+	if err != nil {
+		t.Fatalf("EditSection failed: %v", err)
+	}
+	if !result.Success || result.RevisionID != 77 {
+		t.Errorf("result = %+v, want success with revision 77", result)
+	}
+	if got.Get("section") != "2" {
+		t.Errorf("section param = %q, want %q", got.Get("section"), "2")
+	}
+	if got.Get("text") != sectionText {
+		t.Errorf("text param = %q, want only the section content", got.Get("text"))
+	}
+	if got.Get("baserevid") != "76" {
+		t.Errorf("baserevid param = %q, want %q", got.Get("baserevid"), "76")
+	}
+	if got.Get("nocreate") != "1" {
+		t.Error("expected nocreate=1 so a section edit cannot create a page")
+	}
+}
+
+func TestEditSection_EditConflict(t *testing.T) {
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("action") == "edit" {
+			response := map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": "editconflict",
+					"info": "Edit conflict.",
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.EditSection(context.Background(), EditSectionArgs{
+		Title:     "Guide",
+		Section:   intPtr(1),
+		Content:   "== Intro ==\nText",
+		BaseRevID: 5,
+	})
+	if err == nil {
+		t.Fatal("expected edit conflict error")
+	}
+	wikiErr, ok := err.(*WikiError)
+	if !ok {
+		t.Fatalf("expected *WikiError, got %T: %v", err, err)
+	}
+	if wikiErr.Code != "editconflict" {
+		t.Errorf("Code = %q, want editconflict", wikiErr.Code)
+	}
+	if !strings.Contains(wikiErr.Suggestion, "latest version") {
+		t.Errorf("expected recovery suggestion, got %q", wikiErr.Suggestion)
+	}
+}
+
+func TestEditSection_Validation(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	tests := []struct {
+		name  string
+		args  EditSectionArgs
+		field string
+	}{
+		{"missing title", EditSectionArgs{Section: intPtr(1), Content: "x"}, "title"},
+		{"missing section", EditSectionArgs{Title: "Guide", Content: "x"}, "section"},
+		{"negative section", EditSectionArgs{Title: "Guide", Section: intPtr(-1), Content: "x"}, "section"},
+		{"missing content", EditSectionArgs{Title: "Guide", Section: intPtr(0)}, "content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.EditSection(context.Background(), tt.args)
+			valErr, ok := err.(*ValidationError)
+			if !ok {
+				t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+			}
+			if valErr.Field != tt.field {
+				t.Errorf("Field = %q, want %q", valErr.Field, tt.field)
+			}
+		})
+	}
+}
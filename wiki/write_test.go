@@ -365,6 +365,109 @@ func TestEditPage_CaptchaArgsPassthrough(t *testing.T) {
 	}
 }
 
+func TestEditPage_AppendAndPrepend(t *testing.T) {
+	var form url.Values
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("action") == "edit" {
+			form = r.PostForm
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"edit": map[string]interface{}{
+					"result": "Success", "pageid": float64(123), "title": "Changelog", "newrevid": float64(457),
+				},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+	var audit bytes.Buffer
+	client.SetAuditLogger(NewWriterAuditLogger(&audit, client.logger))
+
+	result, err := client.EditPage(context.Background(), EditPageArgs{
+		Title:       "Changelog",
+		AppendText:  "\n* 2026-10-15: Released 2.1",
+		PrependText: "{{Current}}\n",
+		Summary:     "Add release entry",
+		Minor:       true,
+		Bot:         true,
+	})
+	if err != nil {
+		t.Fatalf("EditPage failed: %v", err)
+	}
+	if !result.Success || result.RevisionID != 457 {
+		t.Errorf("result = %+v, want success with revision 457", result)
+	}
+	if _, sent := form["text"]; sent {
+		t.Error("append/prepend edit must not send the full text parameter")
+	}
+	if form.Get("appendtext") != "\n* 2026-10-15: Released 2.1" || form.Get("prependtext") != "{{Current}}\n" {
+		t.Errorf("appendtext=%q prependtext=%q", form.Get("appendtext"), form.Get("prependtext"))
+	}
+	if form.Get("summary") != "Add release entry" || form.Get("minor") != "1" || form.Get("bot") != "1" {
+		t.Errorf("summary=%q minor=%q bot=%q, want the edit flags passed through", form.Get("summary"), form.Get("minor"), form.Get("bot"))
+	}
+
+	var entry AuditEntry
+	if err := json.Unmarshal(audit.Bytes(), &entry); err != nil {
+		t.Fatalf("audit entry: %v (%q)", err, audit.String())
+	}
+	added := "{{Current}}\n\n* 2026-10-15: Released 2.1"
+	if entry.ContentHash != hashContent(added) || entry.ContentSize != len(added) {
+		t.Errorf("audit hash/size = %s/%d, want the added text's", entry.ContentHash, entry.ContentSize)
+	}
+}
+
+func TestEditPage_AppendWithContentRejected(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	_, err := client.EditPage(context.Background(), EditPageArgs{
+		Title:      "Changelog",
+		Content:    "Whole page",
+		AppendText: "More",
+	})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("error = %T %v, want *ValidationError", err, err)
+	}
+}
+
+func TestEditPage_NoRetryOnOtherAPIErrors(t *testing.T) {
+	attempts := 0
+	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("action") == "edit" {
+			attempts++
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code": "protectedpage",
+					"info": "This page has been protected (not a badtoken).",
+				},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.EditPage(context.Background(), EditPageArgs{
+		Title:   "Test Page",
+		Content: "Content",
+	})
+	if !IsAPIErrorCode(err, "protectedpage") {
+		t.Fatalf("expected a protectedpage API error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 edit attempt, got %d", attempts)
+	}
+}
+
 func TestEditPage_BadTokenRetry(t *testing.T) {
 	attempts := 0
 	server := mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
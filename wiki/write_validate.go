@@ -0,0 +1,115 @@
+package wiki
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Parser output patterns inspected by ValidateWikitext.
+var (
+	// parserErrorRegex matches the error markup MediaWiki emits for template
+	// loops, expression errors, missing Lua modules, and similar failures.
+	parserErrorRegex = regexp.MustCompile(`(?is)<(span|strong|div|p)\b[^>]*class="[^"]*\berror\b[^"]*"[^>]*>(.*?)</(?:span|strong|div|p)>`)
+
+	// preformattedBlockRegex matches rendered code blocks, whose literal
+	// braces are intentional and must not be reported as leftover markup.
+	preformattedBlockRegex = regexp.MustCompile(`(?is)<(pre|code)\b[^>]*>.*?</(?:pre|code)>`)
+)
+
+// leftoverMarkup lists wikitext tokens that only survive into rendered HTML
+// when they were not matched by the parser.
+var leftoverMarkup = []struct {
+	Token   string
+	Message string
+}{
+	{"{{", "unclosed template: '{{' appears in the rendered output"},
+	{"}}", "unopened template: '}}' appears in the rendered output"},
+	{"{|", "malformed table: '{|' appears in the rendered output"},
+	{"|}", "unopened table: '|}' appears in the rendered output"},
+}
+
+// ValidateWikitext parse-checks wikitext with action=parse without saving it,
+// returning any parser warnings, rendered parser errors, and template or
+// table markup the parser left unmatched. An empty slice means the wikitext
+// parsed cleanly.
+func (c *Client) ValidateWikitext(ctx context.Context, wikitext, title string) ([]WikitextIssue, error) {
+	if wikitext == "" {
+		return nil, fmt.Errorf("wikitext is required")
+	}
+
+	if err := c.EnsureLoggedIn(ctx); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("action", "parse")
+	params.Set("text", wikitext)
+	params.Set("contentmodel", "wikitext")
+	params.Set("prop", "text|parsewarnings")
+	params.Set("disablelimitreport", "1")
+	if title != "" {
+		params.Set("title", title)
+	}
+
+	resp, err := c.apiRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	parse, ok := resp["parse"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected API response: missing 'parse' object")
+	}
+
+	issues := make([]WikitextIssue, 0)
+	for _, w := range getSlice(parse["parsewarnings"]) {
+		if msg := getString(w); msg != "" {
+			issues = append(issues, WikitextIssue{Type: "parser_warning", Message: msg})
+		}
+	}
+
+	html := getString(getMap(parse["text"])["*"])
+	issues = append(issues, findRenderedParserIssues(html)...)
+	return issues, nil
+}
+
+// findRenderedParserIssues inspects parsed HTML for parser error markup and
+// for template/table tokens that were rendered as literal text.
+func findRenderedParserIssues(html string) []WikitextIssue {
+	var issues []WikitextIssue
+	for _, m := range parserErrorRegex.FindAllStringSubmatch(html, -1) {
+		if msg := stripHTMLTags(m[2]); msg != "" {
+			issues = append(issues, WikitextIssue{Type: "parser_error", Message: msg})
+		}
+	}
+
+	text := stripHTMLTags(preformattedBlockRegex.ReplaceAllString(html, ""))
+	for _, lm := range leftoverMarkup {
+		if strings.Contains(text, lm.Token) {
+			issues = append(issues, WikitextIssue{Type: "unbalanced_markup", Message: lm.Message})
+		}
+	}
+	return issues
+}
+
+// newWikitextIssuesError converts parse-check issues into a ValidationError
+// that refuses the edit.
+func newWikitextIssuesError(issues []WikitextIssue) error {
+	var sb strings.Builder
+	for _, issue := range issues {
+		sb.WriteString(fmt.Sprintf("- [%s] %s\n", issue.Type, issue.Message))
+	}
+	return &ValidationError{
+		Field:   "content",
+		Message: fmt.Sprintf("wikitext failed the parse check with %d issue(s):\n%s", len(issues), strings.TrimRight(sb.String(), "\n")),
+		Suggestion: `Fix the reported markup and retry. Common causes:
+- A template call missing its closing '}}'
+- A table missing its opening '{|' or closing '|}'
+- A reference to a missing template or module
+
+To save anyway, retry without validate_first.`,
+	}
+}
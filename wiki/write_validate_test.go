@@ -0,0 +1,156 @@
+package wiki
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// parseCheckServer returns a mock server that renders html for action=parse
+// and counts action=edit calls in edits.
+func parseCheckServer(t *testing.T, html string, warnings []string, edits *int) *httptest.Server {
+	t.Helper()
+	return mockMediaWikiServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.FormValue("action") {
+		case "parse":
+			response := map[string]interface{}{
+				"parse": map[string]interface{}{
+					"title":         "Test",
+					"text":          map[string]interface{}{"*": html},
+					"parsewarnings": warnings,
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+		case "edit":
+			*edits++
+			response := map[string]interface{}{
+				"edit": map[string]interface{}{
+					"result":   "Success",
+					"pageid":   float64(1),
+					"title":    "Test",
+					"newrevid": float64(2),
+				},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(response)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func TestValidateWikitext(t *testing.T) {
+	tests := []struct {
+		name      string
+		html      string
+		warnings  []string
+		wantTypes []string
+	}{
+		{
+			name: "valid wikitext",
+			html: `<div class="mw-parser-output"><p>Hello <b>world</b></p><table><tr><td>A</td></tr></table></div>`,
+		},
+		{
+			name:      "unbalanced template",
+			html:      `<div class="mw-parser-output"><p>{{Infobox|name=Foo</p></div>`,
+			wantTypes: []string{"unbalanced_markup"},
+		},
+		{
+			name:      "stray table close",
+			html:      `<div class="mw-parser-output"><p>Text |}</p></div>`,
+			wantTypes: []string{"unbalanced_markup"},
+		},
+		{
+			name:      "parser error and warning",
+			html:      `<div class="mw-parser-output"><p><span class="error">Template loop detected: <a href="/wiki/Template:Loop">Template:Loop</a></span></p></div>`,
+			warnings:  []string{"Expensive parser function count exceeded"},
+			wantTypes: []string{"parser_warning", "parser_error"},
+		},
+		{
+			name: "braces inside code blocks ignored",
+			html: `<div class="mw-parser-output"><pre>{{not a template</pre><p><code>{|</code></p></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edits := 0
+			server := parseCheckServer(t, tt.html, tt.warnings, &edits)
+			defer server.Close()
+
+			client := createMockClient(t, server)
+			defer client.Close()
+
+			issues, err := client.ValidateWikitext(context.Background(), "irrelevant", "Test")
+			if err != nil {
+				t.Fatalf("ValidateWikitext failed: %v", err)
+			}
+			if len(issues) != len(tt.wantTypes) {
+				t.Fatalf("got %d issues %+v, want types %v", len(issues), issues, tt.wantTypes)
+			}
+			for i, want := range tt.wantTypes {
+				if issues[i].Type != want {
+					t.Errorf("issues[%d].Type = %q, want %q", i, issues[i].Type, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateWikitext_EmptyInput(t *testing.T) {
+	client := createTestClient(t)
+	defer client.Close()
+
+	if _, err := client.ValidateWikitext(context.Background(), "", "Test"); err == nil {
+		t.Error("expected error for empty wikitext")
+	}
+}
+
+func TestEditPage_ValidateFirstBlocksBrokenWikitext(t *testing.T) {
+	edits := 0
+	server := parseCheckServer(t, `<p>{{Broken</p>`, nil, &edits)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	_, err := client.EditPage(context.Background(), EditPageArgs{
+		Title:         "Test",
+		Content:       "{{Broken",
+		ValidateFirst: true,
+	})
+	valErr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %T: %v", err, err)
+	}
+	if valErr.Field != "content" {
+		t.Errorf("Field = %q, want content", valErr.Field)
+	}
+	if edits != 0 {
+		t.Errorf("edit was submitted %d time(s) despite failed validation", edits)
+	}
+}
+
+func TestEditPage_ValidateFirstAllowsCleanWikitext(t *testing.T) {
+	edits := 0
+	server := parseCheckServer(t, `<p>Clean</p>`, nil, &edits)
+	defer server.Close()
+
+	client := createMockClient(t, server)
+	defer client.Close()
+
+	result, err := client.EditPage(context.Background(), EditPageArgs{
+		Title:         "Test",
+		Content:       "Clean",
+		ValidateFirst: true,
+	})
+	if err != nil {
+		t.Fatalf("EditPage failed: %v", err)
+	}
+	if !result.Success || edits != 1 {
+		t.Errorf("expected one successful edit, got success=%v edits=%d", result.Success, edits)
+	}
+}